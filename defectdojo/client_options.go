@@ -0,0 +1,69 @@
+package defectdojo
+
+import (
+	"net/http"
+	"strings"
+)
+
+// Option configures a Client created with NewClient.
+type Option func(*Client)
+
+// WithToken sets the API v2 key used to authenticate requests.
+func WithToken(token string) Option {
+	return func(c *Client) {
+		c.Token = token
+	}
+}
+
+// WithHTTPClient sets the HTTP client used to send requests.
+// A nil httpClient leaves http.DefaultClient in place.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		if httpClient != nil {
+			c.HTTPClient = httpClient
+		}
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request.
+func WithUserAgent(ua string) Option {
+	return func(c *Client) {
+		if len(ua) > 0 {
+			c.userAgent = ua
+		}
+	}
+}
+
+// WithAPIPath sets the path of the API relative to the instance URL, for
+// instances mounted under a sub-path. It defaults to "/api/v2".
+func WithAPIPath(path string) Option {
+	return func(c *Client) {
+		path = strings.Trim(path, "/")
+		if len(path) > 0 {
+			path = "/" + path
+		}
+		c.apiPath = path
+	}
+}
+
+// WithHeader adds a header sent with every request. It can be used multiple times,
+// including for the same key.
+func WithHeader(key, value string) Option {
+	return func(c *Client) {
+		c.headers.Add(key, value)
+	}
+}
+
+// WithAllowUnknownScanTypes sends scan imports and reimports whose scan type is not a known parser.
+func WithAllowUnknownScanTypes() Option {
+	return func(c *Client) {
+		c.AllowUnknownScanTypes = true
+	}
+}
+
+// WithRetryPolicy sets the policy used to retry requests failing with transient errors.
+func WithRetryPolicy(p *RetryPolicy) Option {
+	return func(c *Client) {
+		c.RetryPolicy = p
+	}
+}
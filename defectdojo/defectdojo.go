@@ -4,13 +4,16 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
+	"strings"
 )
 
 const (
-	userAgent     = "go-defectdojo"
-	mediaTypeJson = "application/json"
+	userAgent      = "go-defectdojo"
+	mediaTypeJson  = "application/json"
+	defaultAPIPath = "/api/v2"
 )
 
 type Client struct {
@@ -18,60 +21,124 @@ type Client struct {
 	Token      string
 	HTTPClient *http.Client
 
-	ApiTokenAuth     *ApiTokenAuthService
-	DojoGroups       *DojoGroupsService
-	Engagements      *EngagementsService
-	Findings         *FindingsService
-	ImportScan       *ImportScanService
-	Notes            *NotesService
-	ProductTypes     *ProductTypesService
-	Products         *ProductsService
-	ReImportScan     *ReImportScanService
-	Technologies     *TechnologiesService
-	Tests            *TestsService
-	TestTypes        *TestTypesService
-	ToolTypes        *ToolTypesService
-	UserContactInfos *UserContactInfosService
-	UserProfile      *UserProfileService
-	Users            *UsersService
+	// RetryPolicy configures retries of requests failing with transient errors.
+	// When nil, requests are sent only once.
+	RetryPolicy *RetryPolicy
+
+	// AllowUnknownScanTypes sends scan imports and reimports whose scan type is not in the
+	// catalogue returned by ScanTypes, leaving DefectDojo to check it. By default they fail
+	// before the report is uploaded.
+	AllowUnknownScanTypes bool
+
+	userAgent string
+	apiPath   string
+	headers   http.Header
+
+	ApiTokenAuth              *ApiTokenAuthService
+	DevelopmentEnvironments   *DevelopmentEnvironmentsService
+	DojoGroupMembers          *DojoGroupMembersService
+	DojoGroups                *DojoGroupsService
+	Endpoints                 *EndpointsService
+	EndpointStatus            *EndpointStatusService
+	Engagements               *EngagementsService
+	FindingGroups             *FindingGroupsService
+	Findings                  *FindingsService
+	GlobalRoles               *GlobalRolesService
+	ImportLanguages           *ImportLanguagesService
+	ImportScan                *ImportScanService
+	JiraFindingMappings       *JiraFindingMappingsService
+	JiraInstances             *JiraInstancesService
+	JiraProductConfigurations *JiraProductConfigurationsService
+	JiraProjects              *JiraProjectsService
+	LanguageTypes             *LanguageTypesService
+	Languages                 *LanguagesService
+	Metadata                  *MetadataService
+	Notes                     *NotesService
+	NoteTypes                 *NoteTypesService
+	ProductGroups             *ProductGroupsService
+	ProductMembers            *ProductMembersService
+	ProductTypeGroups         *ProductTypeGroupsService
+	ProductTypeMembers        *ProductTypeMembersService
+	ProductTypes              *ProductTypesService
+	Products                  *ProductsService
+	Regulations               *RegulationsService
+	ReImportScan              *ReImportScanService
+	RiskAcceptances           *RiskAcceptancesService
+	Roles                     *RolesService
+	SLAConfigurations         *SLAConfigurationsService
+	Technologies              *TechnologiesService
+	Tests                     *TestsService
+	TestTypes                 *TestTypesService
+	ToolTypes                 *ToolTypesService
+	UserContactInfos          *UserContactInfosService
+	UserProfile               *UserProfileService
+	Users                     *UsersService
 }
 
-type errorResponse struct {
-	Code        int      `json:"code,omitempty"`
-	Detail      string   `json:"detail,omitempty"`
-	Description []string `json:"description,omitempty"`
-	Message     string   `json:"message,omitempty"`
+// NewDojoClient returns a new DefectDojo API client for the instance at dojourl,
+// authenticating with token. If httpClient is nil, http.DefaultClient is used.
+//
+// NewDojoClient is kept for compatibility; new code should prefer NewClient.
+func NewDojoClient(dojourl string, token string, httpClient *http.Client) (*Client, error) {
+	return NewClient(dojourl, WithToken(token), WithHTTPClient(httpClient))
 }
 
-func NewDojoClient(dojourl string, token string, httpClient *http.Client) (*Client, error) {
-	if httpClient == nil {
-		httpClient = http.DefaultClient
+// NewClient returns a new DefectDojo API client for the instance at dojourl,
+// configured by the given options.
+func NewClient(dojourl string, opts ...Option) (*Client, error) {
+	if len(dojourl) == 0 {
+		return nil, errors.New("NewClient: cannot create client, URL string is empty")
 	}
 
-	if len(dojourl) == 0 {
-		return nil, errors.New("NewDojoClient: cannot create client, URL string is empty")
+	c := &Client{
+		HTTPClient: http.DefaultClient,
+		userAgent:  userAgent,
+		apiPath:    defaultAPIPath,
+		headers:    make(http.Header),
 	}
 
-	baseurl, err := url.Parse(dojourl + "/api/v2")
-	if err != nil {
-		return nil, fmt.Errorf("NewDojoClient: cannot parse URL: %w", err)
+	for _, opt := range opts {
+		opt(c)
 	}
 
-	c := &Client{
-		BaseURL:    baseurl,
-		Token:      token,
-		HTTPClient: httpClient,
+	baseurl, err := url.Parse(strings.TrimSuffix(dojourl, "/") + c.apiPath)
+	if err != nil {
+		return nil, fmt.Errorf("NewClient: cannot parse URL: %w", err)
 	}
+	c.BaseURL = baseurl
 
 	c.ApiTokenAuth = &ApiTokenAuthService{client: c}
+	c.DevelopmentEnvironments = &DevelopmentEnvironmentsService{client: c}
+	c.DojoGroupMembers = &DojoGroupMembersService{client: c}
 	c.DojoGroups = &DojoGroupsService{client: c}
+	c.Endpoints = &EndpointsService{client: c}
+	c.EndpointStatus = &EndpointStatusService{client: c}
 	c.Engagements = &EngagementsService{client: c}
+	c.FindingGroups = &FindingGroupsService{client: c}
 	c.Findings = &FindingsService{client: c}
+	c.GlobalRoles = &GlobalRolesService{client: c}
+	c.ImportLanguages = &ImportLanguagesService{client: c}
 	c.ImportScan = &ImportScanService{client: c}
+	c.JiraFindingMappings = &JiraFindingMappingsService{client: c}
+	c.JiraInstances = &JiraInstancesService{client: c}
+	c.JiraProductConfigurations = &JiraProductConfigurationsService{client: c}
+	c.JiraProjects = &JiraProjectsService{client: c}
+	c.LanguageTypes = &LanguageTypesService{client: c}
+	c.Languages = &LanguagesService{client: c}
+	c.Metadata = &MetadataService{client: c}
 	c.Notes = &NotesService{client: c}
+	c.NoteTypes = &NoteTypesService{client: c}
+	c.ProductGroups = &ProductGroupsService{client: c}
+	c.ProductMembers = &ProductMembersService{client: c}
+	c.ProductTypeGroups = &ProductTypeGroupsService{client: c}
+	c.ProductTypeMembers = &ProductTypeMembersService{client: c}
 	c.ProductTypes = &ProductTypesService{client: c}
 	c.Products = &ProductsService{client: c}
+	c.Regulations = &RegulationsService{client: c}
 	c.ReImportScan = &ReImportScanService{client: c}
+	c.RiskAcceptances = &RiskAcceptancesService{client: c}
+	c.Roles = &RolesService{client: c}
+	c.SLAConfigurations = &SLAConfigurationsService{client: c}
 	c.Technologies = &TechnologiesService{client: c}
 	c.Tests = &TestsService{client: c}
 	c.TestTypes = &TestTypesService{client: c}
@@ -84,7 +151,17 @@ func NewDojoClient(dojourl string, token string, httpClient *http.Client) (*Clie
 }
 
 func (c *Client) sendRequest(req *http.Request, v interface{}) error {
-	req.Header.Set("User-Agent", userAgent)
+	for key, values := range c.headers {
+		for _, v := range values {
+			req.Header.Add(key, v)
+		}
+	}
+
+	ua := c.userAgent
+	if len(ua) == 0 {
+		ua = userAgent
+	}
+	req.Header.Set("User-Agent", ua)
 	req.Header.Set("Accept", mediaTypeJson)
 
 	if len(c.Token) > 0 {
@@ -95,25 +172,54 @@ func (c *Client) sendRequest(req *http.Request, v interface{}) error {
 		req.Header.Set("Content-Type", mediaTypeJson)
 	}
 
-	res, err := c.HTTPClient.Do(req)
+	res, err := c.do(req)
 	if err != nil {
 		return fmt.Errorf("sendRequest: cannot send request: %w", err)
 	}
 	defer func() { _ = res.Body.Close() }()
 
 	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusBadRequest {
-		errorResp := errorResponse{
-			Code: res.StatusCode,
+		body, err := io.ReadAll(res.Body)
+		if err != nil {
+			return fmt.Errorf("sendRequest: cannot read error response, status code: %d: %w", res.StatusCode, err)
 		}
-		if err = json.NewDecoder(res.Body).Decode(&errorResp); err == nil {
-			return fmt.Errorf("sendRequest: API error: %v", errorResp)
+		return fmt.Errorf("sendRequest: %w", newAPIError(res, body))
+	}
+
+	if res.StatusCode == http.StatusNoContent || v == nil {
+		return nil
+	}
+
+	if w, ok := v.(io.Writer); ok {
+		if _, err = io.Copy(w, res.Body); err != nil {
+			return fmt.Errorf("sendRequest: cannot read reponse: %w", err)
 		}
-		return fmt.Errorf("sendRequest: unknown error, status code: %d", res.StatusCode)
+		return nil
 	}
 
-	if err = json.NewDecoder(res.Body).Decode(v); err != nil {
+	if err = json.NewDecoder(res.Body).Decode(v); err != nil && !errors.Is(err, io.EOF) {
 		return fmt.Errorf("sendRequest: cannot decode reponse: %w", err)
 	}
 
 	return nil
 }
+
+// do sends req, retrying it according to the client's RetryPolicy.
+func (c *Client) do(req *http.Request) (*http.Response, error) {
+	for attempt := 1; ; attempt++ {
+		res, err := c.HTTPClient.Do(req)
+		if !c.RetryPolicy.shouldRetry(req, res, err, attempt) {
+			return res, err
+		}
+
+		wait := c.RetryPolicy.backoff(res, attempt)
+		discard(res)
+
+		if err := sleep(req.Context(), wait); err != nil {
+			return nil, err
+		}
+		if err := rewind(req); err != nil {
+			return nil, err
+		}
+	}
+}
@@ -1,7 +1,10 @@
 package defectdojo
 
 import (
+	"context"
+	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -18,6 +21,80 @@ func TestNewDojoClient(t *testing.T) {
 	})
 }
 
+func TestNewClient(t *testing.T) {
+
+	t.Run("empty URL", func(t *testing.T) {
+
+		_, err := NewClient("")
+		if cmp.Equal(err, nil) {
+			t.Errorf("expected an error with an empty URL")
+		}
+	})
+
+	t.Run("defaults", func(t *testing.T) {
+
+		c, err := NewClient("https://dojo.example.com/")
+		if !cmp.Equal(err, nil) {
+			t.Fatalf("error: %s", err)
+		}
+		if c.BaseURL.String() != "https://dojo.example.com/api/v2" {
+			t.Errorf("unexpected base URL %s", c.BaseURL)
+		}
+		if c.HTTPClient != http.DefaultClient {
+			t.Errorf("expected http.DefaultClient")
+		}
+	})
+
+	t.Run("options", func(t *testing.T) {
+
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/dojo/api/v2/products/1/" {
+				t.Errorf("unexpected path %s", r.URL.Path)
+			}
+			if r.Header.Get("Authorization") != "Token secret" {
+				t.Errorf("unexpected Authorization header %s", r.Header.Get("Authorization"))
+			}
+			if r.Header.Get("User-Agent") != "my-tool/1.0" {
+				t.Errorf("unexpected User-Agent header %s", r.Header.Get("User-Agent"))
+			}
+			if !cmp.Equal(r.Header.Values("X-Team"), []string{"a", "b"}) {
+				t.Errorf("unexpected X-Team header %v", r.Header.Values("X-Team"))
+			}
+			_, _ = fmt.Fprintln(w, `{"id": 1}`)
+		}))
+		defer ts.Close()
+
+		hc := &http.Client{}
+		c, err := NewClient(ts.URL,
+			WithToken("secret"),
+			WithHTTPClient(hc),
+			WithUserAgent("my-tool/1.0"),
+			WithAPIPath("/dojo/api/v2/"),
+			WithHeader("X-Team", "a"),
+			WithHeader("X-Team", "b"),
+			WithRetryPolicy(DefaultRetryPolicy()),
+			WithAllowUnknownScanTypes(),
+		)
+		if !cmp.Equal(err, nil) {
+			t.Fatalf("error: %s", err)
+		}
+		if c.HTTPClient != hc {
+			t.Errorf("expected the provided HTTP client")
+		}
+		if c.RetryPolicy == nil {
+			t.Errorf("expected a retry policy")
+		}
+		if !c.AllowUnknownScanTypes {
+			t.Errorf("expected unknown scan types to be allowed")
+		}
+
+		_, err = c.Products.Read(context.Background(), 1)
+		if !cmp.Equal(err, nil) {
+			t.Errorf("error: %s", err)
+		}
+	})
+}
+
 func TestClient_sendRequest(t *testing.T) {
 
 	t.Run("server unavailable", func(t *testing.T) {
@@ -29,7 +106,7 @@ func TestClient_sendRequest(t *testing.T) {
 			t.Errorf("ERR")
 		}
 
-		var res errorResponse
+		var res map[string]interface{}
 		err = c.sendRequest(req, &res)
 		if cmp.Equal(err, nil) {
 			t.Errorf("expected an error with server unavailable")
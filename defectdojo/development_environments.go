@@ -0,0 +1,217 @@
+package defectdojo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+type DevelopmentEnvironmentsService struct {
+	client *Client
+}
+
+type DevelopmentEnvironment struct {
+	Id   *int    `json:"id,omitempty"`
+	Name *string `json:"name,omitempty"`
+}
+
+type DevelopmentEnvironments struct {
+	Count    *int                      `json:"count,omitempty"`
+	Next     *string                   `json:"next,omitempty"`
+	Previous *string                   `json:"previous,omitempty"`
+	Results  *[]DevelopmentEnvironment `json:"results,omitempty"`
+}
+
+type DevelopmentEnvironmentsOptions struct {
+	Limit  int
+	Offset int
+	ID     int
+	Name   string
+}
+
+func (o *DevelopmentEnvironmentsOptions) ToString() string {
+	if o == nil {
+		return ""
+	}
+
+	v := url.Values{}
+	if o.Limit > 0 {
+		v.Set("limit", strconv.Itoa(o.Limit))
+	}
+	if o.Offset > 0 {
+		v.Set("offset", strconv.Itoa(o.Offset))
+	}
+	if o.ID > 0 {
+		v.Set("id", strconv.Itoa(o.ID))
+	}
+	if len(o.Name) > 0 {
+		v.Set("name", o.Name)
+	}
+
+	return "?" + v.Encode()
+}
+
+func (c *DevelopmentEnvironmentsService) List(ctx context.Context, options *DevelopmentEnvironmentsOptions) (*DevelopmentEnvironments, error) {
+	path := fmt.Sprintf("%s/development_environments/%s", c.client.BaseURL, options.ToString())
+
+	req, err := http.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := DevelopmentEnvironments{}
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// All returns an iterator over all development environments matching the given options,
+// fetching further pages from DefectDojo as the iteration advances.
+func (c *DevelopmentEnvironmentsService) All(ctx context.Context, options *DevelopmentEnvironmentsOptions) iter.Seq2[DevelopmentEnvironment, error] {
+	path := fmt.Sprintf("%s/development_environments/%s", c.client.BaseURL, options.ToString())
+
+	return all[DevelopmentEnvironment](ctx, c.client, path)
+}
+
+func (c *DevelopmentEnvironmentsService) Read(ctx context.Context, id int) (*DevelopmentEnvironment, error) {
+	path := fmt.Sprintf("%s/development_environments/%d/", c.client.BaseURL, id)
+
+	req, err := http.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(DevelopmentEnvironment)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *DevelopmentEnvironmentsService) Create(ctx context.Context, u *DevelopmentEnvironment) (*DevelopmentEnvironment, error) {
+	path := fmt.Sprintf("%s/development_environments/", c.client.BaseURL)
+
+	postJSON, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPost, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(DevelopmentEnvironment)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *DevelopmentEnvironmentsService) Update(ctx context.Context, id int, u *DevelopmentEnvironment) (*DevelopmentEnvironment, error) {
+	path := fmt.Sprintf("%s/development_environments/%d/", c.client.BaseURL, id)
+
+	postJSON, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPut, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(DevelopmentEnvironment)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *DevelopmentEnvironmentsService) PartialUpdate(ctx context.Context, id int, u *DevelopmentEnvironment) (*DevelopmentEnvironment, error) {
+	path := fmt.Sprintf("%s/development_environments/%d/", c.client.BaseURL, id)
+
+	postJSON, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPatch, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(DevelopmentEnvironment)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *DevelopmentEnvironmentsService) Delete(ctx context.Context, id int) (*DevelopmentEnvironment, error) {
+	path := fmt.Sprintf("%s/development_environments/%d/", c.client.BaseURL, id)
+
+	req, err := http.NewRequest(http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(DevelopmentEnvironment)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// Ensure returns the development environment with the given name, creating it if it does not exist yet.
+// Scan imports fail when their Environment does not exist, so pipelines can call Ensure beforehand.
+func (c *DevelopmentEnvironmentsService) Ensure(ctx context.Context, name string) (*DevelopmentEnvironment, error) {
+	env, err := c.byName(ctx, name)
+	if err != nil || env != nil {
+		return env, err
+	}
+
+	env, err = c.Create(ctx, &DevelopmentEnvironment{Name: Str(name)})
+	if IsBadRequest(err) {
+		// names are unique, the environment may have been created concurrently
+		if existing, lookupErr := c.byName(ctx, name); lookupErr == nil && existing != nil {
+			return existing, nil
+		}
+	}
+
+	return env, err
+}
+
+func (c *DevelopmentEnvironmentsService) byName(ctx context.Context, name string) (*DevelopmentEnvironment, error) {
+	for env, err := range c.All(ctx, &DevelopmentEnvironmentsOptions{Name: name}) {
+		if err != nil {
+			return nil, err
+		}
+		if env.Name != nil && *env.Name == name {
+			return &env, nil
+		}
+	}
+
+	return nil, nil
+}
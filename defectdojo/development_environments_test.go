@@ -0,0 +1,340 @@
+package defectdojo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDevelopmentEnvironmentsService_List(t *testing.T) {
+	response := `{
+		"count": 1,
+		"next": null,
+		"previous": null,
+		"results": [
+			{
+				"id": 5,
+				"name": "staging-eu"
+			}
+		]
+	}`
+
+	expected := DevelopmentEnvironments{
+		Count:    Int(1),
+		Next:     nil,
+		Previous: nil,
+		Results: &[]DevelopmentEnvironment{
+			{
+				Id:   Int(5),
+				Name: Str("staging-eu"),
+			},
+		},
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected GET request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/development_environments/") {
+			t.Errorf("Expected /development_environments/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.DevelopmentEnvironments.List(context.Background(), &DevelopmentEnvironmentsOptions{
+		Limit: 10,
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestDevelopmentEnvironmentsService_Read(t *testing.T) {
+	response := `{
+		"id": 5,
+		"name": "staging-eu"
+	}`
+
+	expected := DevelopmentEnvironment{
+		Id:   Int(5),
+		Name: Str("staging-eu"),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected GET request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/development_environments/5/") {
+			t.Errorf("Expected /development_environments/5/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.DevelopmentEnvironments.Read(context.Background(), 5)
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestDevelopmentEnvironmentsService_Create(t *testing.T) {
+	response := `{
+		"id": 5,
+		"name": "staging-eu"
+	}`
+
+	expected := DevelopmentEnvironment{
+		Id:   Int(5),
+		Name: Str("staging-eu"),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("Expected POST request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/development_environments/") {
+			t.Errorf("Expected /development_environments/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.DevelopmentEnvironments.Create(context.Background(), &DevelopmentEnvironment{
+		Name: Str("staging-eu"),
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestDevelopmentEnvironmentsService_Update(t *testing.T) {
+	response := `{
+		"id": 5,
+		"name": "staging-eu"
+	}`
+
+	expected := DevelopmentEnvironment{
+		Id:   Int(5),
+		Name: Str("staging-eu"),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Errorf("Expected PUT request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/development_environments/5/") {
+			t.Errorf("Expected /development_environments/5/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.DevelopmentEnvironments.Update(context.Background(), 5, &DevelopmentEnvironment{
+		Name: Str("staging-eu"),
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestDevelopmentEnvironmentsService_PartialUpdate(t *testing.T) {
+	response := `{
+		"id": 5,
+		"name": "staging-eu"
+	}`
+
+	expected := DevelopmentEnvironment{
+		Id:   Int(5),
+		Name: Str("staging-eu"),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch {
+			t.Errorf("Expected PATCH request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/development_environments/5/") {
+			t.Errorf("Expected /development_environments/5/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.DevelopmentEnvironments.PartialUpdate(context.Background(), 5, &DevelopmentEnvironment{
+		Name: Str("staging-eu"),
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestDevelopmentEnvironmentsService_Delete(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("Expected DELETE request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/development_environments/5/") {
+			t.Errorf("Expected /development_environments/5/ in path, got %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	_, err := dj.DevelopmentEnvironments.Delete(context.Background(), 5)
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+}
+
+func TestDevelopmentEnvironmentsOptions_ToString(t *testing.T) {
+	tests := []struct {
+		name     string
+		options  *DevelopmentEnvironmentsOptions
+		expected string
+	}{
+		{
+			name: "name only",
+			options: &DevelopmentEnvironmentsOptions{
+				Name: "staging-eu",
+			},
+			expected: "?name=staging-eu",
+		},
+		{
+			name: "all fields",
+			options: &DevelopmentEnvironmentsOptions{
+				Limit:  10,
+				Offset: 20,
+				ID:     5,
+			},
+			expected: "?id=5&limit=10&offset=20",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := tt.options.ToString()
+			if actual != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestDevelopmentEnvironmentsService_Ensure(t *testing.T) {
+	t.Run("existing", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodGet {
+				t.Errorf("Expected GET request, got %s", r.Method)
+			}
+			if r.URL.Query().Get("name") != "staging-eu" {
+				t.Errorf("Expected name=staging-eu in query, got %s", r.URL.RawQuery)
+			}
+			_, _ = fmt.Fprintln(w, `{"count": 1, "next": null, "previous": null, "results": [{"id": 5, "name": "staging-eu"}]}`)
+		}))
+		defer ts.Close()
+
+		dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+		actual, err := dj.DevelopmentEnvironments.Ensure(context.Background(), "staging-eu")
+		if !cmp.Equal(err, nil) {
+			t.Fatalf("error: %s", err)
+		}
+
+		expected := &DevelopmentEnvironment{Id: Int(5), Name: Str("staging-eu")}
+		if !cmp.Equal(actual, expected) {
+			t.Errorf("should have been equal, %+v, %+v", actual, expected)
+		}
+	})
+
+	t.Run("missing", func(t *testing.T) {
+		created := false
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodGet:
+				_, _ = fmt.Fprintln(w, `{"count": 1, "next": null, "previous": null, "results": [{"id": 4, "name": "staging-eu-2"}]}`)
+			case http.MethodPost:
+				created = true
+				_, _ = fmt.Fprintln(w, `{"id": 6, "name": "staging-eu"}`)
+			default:
+				t.Errorf("unexpected %s request", r.Method)
+			}
+		}))
+		defer ts.Close()
+
+		dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+		actual, err := dj.DevelopmentEnvironments.Ensure(context.Background(), "staging-eu")
+		if !cmp.Equal(err, nil) {
+			t.Fatalf("error: %s", err)
+		}
+		if !created || *actual.Id != 6 {
+			t.Errorf("expected environment 6 to be created, got %+v", actual)
+		}
+	})
+
+	t.Run("created concurrently", func(t *testing.T) {
+		lookups := 0
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodGet:
+				lookups++
+				if lookups == 1 {
+					_, _ = fmt.Fprintln(w, `{"count": 0, "next": null, "previous": null, "results": []}`)
+					return
+				}
+				_, _ = fmt.Fprintln(w, `{"count": 1, "next": null, "previous": null, "results": [{"id": 7, "name": "staging-eu"}]}`)
+			case http.MethodPost:
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = fmt.Fprintln(w, `{"name": ["Development_ Environment with this name already exists."]}`)
+			}
+		}))
+		defer ts.Close()
+
+		dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+		actual, err := dj.DevelopmentEnvironments.Ensure(context.Background(), "staging-eu")
+		if !cmp.Equal(err, nil) {
+			t.Fatalf("error: %s", err)
+		}
+		if *actual.Id != 7 {
+			t.Errorf("expected environment 7, got %d", *actual.Id)
+		}
+	})
+}
@@ -16,6 +16,15 @@ Define a new Defectdojo client:
 
 	dj, err := defectdojo.NewDojoClient(url, token, nil)
 
+NewClient accepts functional options to customise the client, such as the HTTP client, the User-Agent,
+additional headers or the API path of instances mounted under a sub-path:
+
+	dj, err := defectdojo.NewClient(url,
+		defectdojo.WithToken(token),
+		defectdojo.WithUserAgent("my-tool/1.0"),
+		defectdojo.WithAPIPath("/defectdojo/api/v2"),
+	)
+
 It is possible to specify a custom HTTP Transport when creating the client:
 
 	client := &http.Client{
@@ -42,9 +51,64 @@ These should be used when passing parameters to methods that create/update/delet
 		User:         defectdojo.Int(1),
 	})
 
+Fields with a fixed set of values, such as severities, roles, engagement statuses and types or business criticality,
+use typed constants. Ptr allocates a pointer to any of them, and values outside the set are rejected before the request is sent:
+
+	_, err := dj.Findings.PartialUpdate(ctx, 42, &defectdojo.Finding{
+		Severity: defectdojo.Ptr(defectdojo.SeverityCritical),
+	})
+
+Scan imports check their scan type against the catalogue of DefectDojo parsers before uploading the report.
+The ScanType constants name the parsers, and their Ptr method fills the ScanType field:
+
+	_, err := dj.ImportScan.Create(ctx, &defectdojo.ImportScan{
+		ScanType: defectdojo.ScanTypeTrivy.Ptr(),
+		File:     defectdojo.Str("trivy.json"),
+	})
+
+ScanTypes lists the catalogue, and RegisterScanType adds parsers it does not know about yet.
+Setting AllowUnknownScanTypes on the client skips the check altogether.
+
+List methods return a single page of results. To walk every page, use the matching All method,
+which returns an iterator that follows the "next" link of each page and stops at the first error:
+
+	for finding, err := range dj.Findings.All(ctx, &defectdojo.FindingsOptions{Limit: 100}) {
+		if err != nil {
+			return err
+		}
+		fmt.Println(*finding.Title)
+	}
+
+Helpers adding to or removing from a list held by an object, such as Engagements.AddTags, read the object and
+write the updated list back with a partial update, as DefectDojo has no atomic action for them.
+A concurrent change to the same list made in between is lost.
+
 NOTE: Using the context package, one can easily pass cancellation signals and deadlines to various services of the client for handling a request.
 In case there is no context available, then context.Background() can be used as a starting point.
 
+Errors
+
+When the API answers with a non-2xx status code, the returned error wraps an *APIError carrying the status code,
+the request method and URL, the raw response body and any per-field validation messages:
+
+	_, err := dj.Products.Create(ctx, product)
+	var apiErr *defectdojo.APIError
+	if errors.As(err, &apiErr) {
+		fmt.Println(apiErr.StatusCode, apiErr.FieldErrors)
+	}
+
+The IsNotFound, IsPermissionDenied, IsUnauthorized and IsBadRequest helpers cover the most common checks.
+
+Retries
+
+Requests failing with a transient error can be retried by setting a RetryPolicy on the client.
+Delays grow exponentially with jitter, and a Retry-After header sent by the server takes precedence,
+unless it asks to wait longer than MaxBackoff, in which case the error is returned without retrying:
+
+	dj.RetryPolicy = defectdojo.DefaultRetryPolicy()
+
+Only idempotent requests are retried unless RetryNonIdempotent is set, which also replays scan report uploads.
+
 Authentication
 
 The go-defectdojo library handles authentication via Token. You can retrieve a valid API v2 Key from within your DefectDojo instance.
@@ -0,0 +1,191 @@
+package defectdojo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+type DojoGroupMembersService struct {
+	client *Client
+}
+
+type DojoGroupMember struct {
+	Id    *int    `json:"id,omitempty"`
+	Group *int    `json:"group,omitempty"`
+	User  *int    `json:"user,omitempty"`
+	Role  *RoleID `json:"role,omitempty"`
+}
+
+type DojoGroupMembers struct {
+	Count    *int               `json:"count,omitempty"`
+	Next     *string            `json:"next,omitempty"`
+	Previous *string            `json:"previous,omitempty"`
+	Results  *[]DojoGroupMember `json:"results,omitempty"`
+}
+
+type DojoGroupMembersOptions struct {
+	Limit  int
+	Offset int
+	ID     int
+	Group  int
+	User   int
+}
+
+func (o *DojoGroupMembersOptions) ToString() string {
+	if o == nil {
+		return ""
+	}
+
+	v := url.Values{}
+	if o.Limit > 0 {
+		v.Set("limit", strconv.Itoa(o.Limit))
+	}
+	if o.Offset > 0 {
+		v.Set("offset", strconv.Itoa(o.Offset))
+	}
+	if o.ID > 0 {
+		v.Set("id", strconv.Itoa(o.ID))
+	}
+	if o.Group > 0 {
+		v.Set("group_id", strconv.Itoa(o.Group))
+	}
+	if o.User > 0 {
+		v.Set("user_id", strconv.Itoa(o.User))
+	}
+
+	return "?" + v.Encode()
+}
+
+func (c *DojoGroupMembersService) List(ctx context.Context, options *DojoGroupMembersOptions) (*DojoGroupMembers, error) {
+	path := fmt.Sprintf("%s/dojo_group_members/%s", c.client.BaseURL, options.ToString())
+
+	req, err := http.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := DojoGroupMembers{}
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// All returns an iterator over all dojo group members matching the given options,
+// fetching further pages from DefectDojo as the iteration advances.
+func (c *DojoGroupMembersService) All(ctx context.Context, options *DojoGroupMembersOptions) iter.Seq2[DojoGroupMember, error] {
+	path := fmt.Sprintf("%s/dojo_group_members/%s", c.client.BaseURL, options.ToString())
+
+	return all[DojoGroupMember](ctx, c.client, path)
+}
+
+func (c *DojoGroupMembersService) Read(ctx context.Context, id int) (*DojoGroupMember, error) {
+	path := fmt.Sprintf("%s/dojo_group_members/%d/", c.client.BaseURL, id)
+
+	req, err := http.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(DojoGroupMember)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *DojoGroupMembersService) Create(ctx context.Context, u *DojoGroupMember) (*DojoGroupMember, error) {
+	path := fmt.Sprintf("%s/dojo_group_members/", c.client.BaseURL)
+
+	postJSON, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPost, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(DojoGroupMember)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *DojoGroupMembersService) Update(ctx context.Context, id int, u *DojoGroupMember) (*DojoGroupMember, error) {
+	path := fmt.Sprintf("%s/dojo_group_members/%d/", c.client.BaseURL, id)
+
+	postJSON, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPut, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(DojoGroupMember)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *DojoGroupMembersService) PartialUpdate(ctx context.Context, id int, u *DojoGroupMember) (*DojoGroupMember, error) {
+	path := fmt.Sprintf("%s/dojo_group_members/%d/", c.client.BaseURL, id)
+
+	postJSON, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPatch, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(DojoGroupMember)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *DojoGroupMembersService) Delete(ctx context.Context, id int) (*DojoGroupMember, error) {
+	path := fmt.Sprintf("%s/dojo_group_members/%d/", c.client.BaseURL, id)
+
+	req, err := http.NewRequest(http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(DojoGroupMember)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
@@ -0,0 +1,286 @@
+package defectdojo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDojoGroupMembersService_List(t *testing.T) {
+	response := `{
+		"count": 1,
+		"next": null,
+		"previous": null,
+		"results": [
+			{
+				"id": 7,
+				"group": 3,
+				"user": 12,
+				"role": 3
+			}
+		]
+	}`
+
+	expected := DojoGroupMembers{
+		Count:    Int(1),
+		Next:     nil,
+		Previous: nil,
+		Results: &[]DojoGroupMember{
+			{
+				Id:    Int(7),
+				Group: Int(3),
+				User:  Int(12),
+				Role:  Ptr(RoleMaintainer),
+			},
+		},
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected GET request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/dojo_group_members/") {
+			t.Errorf("Expected /dojo_group_members/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.DojoGroupMembers.List(context.Background(), &DojoGroupMembersOptions{
+		Limit: 10,
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestDojoGroupMembersService_Read(t *testing.T) {
+	response := `{
+		"id": 7,
+		"group": 3,
+		"user": 12,
+		"role": 3
+	}`
+
+	expected := DojoGroupMember{
+		Id:    Int(7),
+		Group: Int(3),
+		User:  Int(12),
+		Role:  Ptr(RoleMaintainer),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected GET request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/dojo_group_members/7/") {
+			t.Errorf("Expected /dojo_group_members/7/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.DojoGroupMembers.Read(context.Background(), 7)
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestDojoGroupMembersService_Create(t *testing.T) {
+	response := `{
+		"id": 7,
+		"group": 3,
+		"user": 12,
+		"role": 3
+	}`
+
+	expected := DojoGroupMember{
+		Id:    Int(7),
+		Group: Int(3),
+		User:  Int(12),
+		Role:  Ptr(RoleMaintainer),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("Expected POST request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/dojo_group_members/") {
+			t.Errorf("Expected /dojo_group_members/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.DojoGroupMembers.Create(context.Background(), &DojoGroupMember{
+		Group: Int(3),
+		User:  Int(12),
+		Role:  Ptr(RoleMaintainer),
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestDojoGroupMembersService_Update(t *testing.T) {
+	response := `{
+		"id": 7,
+		"group": 3,
+		"user": 12,
+		"role": 3
+	}`
+
+	expected := DojoGroupMember{
+		Id:    Int(7),
+		Group: Int(3),
+		User:  Int(12),
+		Role:  Ptr(RoleMaintainer),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Errorf("Expected PUT request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/dojo_group_members/7/") {
+			t.Errorf("Expected /dojo_group_members/7/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.DojoGroupMembers.Update(context.Background(), 7, &DojoGroupMember{
+		Group: Int(3),
+		User:  Int(12),
+		Role:  Ptr(RoleMaintainer),
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestDojoGroupMembersService_PartialUpdate(t *testing.T) {
+	response := `{
+		"id": 7,
+		"group": 3,
+		"user": 12,
+		"role": 3
+	}`
+
+	expected := DojoGroupMember{
+		Id:    Int(7),
+		Group: Int(3),
+		User:  Int(12),
+		Role:  Ptr(RoleMaintainer),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch {
+			t.Errorf("Expected PATCH request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/dojo_group_members/7/") {
+			t.Errorf("Expected /dojo_group_members/7/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.DojoGroupMembers.PartialUpdate(context.Background(), 7, &DojoGroupMember{
+		Group: Int(3),
+		User:  Int(12),
+		Role:  Ptr(RoleMaintainer),
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestDojoGroupMembersService_Delete(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("Expected DELETE request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/dojo_group_members/7/") {
+			t.Errorf("Expected /dojo_group_members/7/ in path, got %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	_, err := dj.DojoGroupMembers.Delete(context.Background(), 7)
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+}
+
+func TestDojoGroupMembersOptions_ToString(t *testing.T) {
+	tests := []struct {
+		name     string
+		options  *DojoGroupMembersOptions
+		expected string
+	}{
+		{
+			name: "group only",
+			options: &DojoGroupMembersOptions{
+				Group: 3,
+			},
+			expected: "?group_id=3",
+		},
+		{
+			name: "all fields",
+			options: &DojoGroupMembersOptions{
+				Limit:  10,
+				Offset: 20,
+				ID:     7,
+				Group:  3,
+				User:   12,
+			},
+			expected: "?group_id=3&id=7&limit=10&offset=20&user_id=12",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := tt.options.ToString()
+			if actual != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, actual)
+			}
+		})
+	}
+}
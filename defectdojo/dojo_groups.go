@@ -1,14 +1,27 @@
 package defectdojo
 
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
 type DojoGroupsService struct {
 	client *Client
 }
 
 type DojoGroup struct {
-	Id          *int    `json:"id,omitempty"`
-	Name        *string `json:"name,omitempty"`
-	Description *string `json:"description,omitempty"`
-	Users       *[]int  `json:"users,omitempty"`
+	Id                       *int    `json:"id,omitempty"`
+	Name                     *string `json:"name,omitempty"`
+	Description              *string `json:"description,omitempty"`
+	ConfigurationPermissions *[]int  `json:"configuration_permissions,omitempty"`
+	SocialProvider           *string `json:"social_provider,omitempty"`
+	Users                    *[]int  `json:"users,omitempty"`
 }
 
 type DojoGroups struct {
@@ -17,3 +30,164 @@ type DojoGroups struct {
 	Previous *string      `json:"previous,omitempty"`
 	Results  *[]DojoGroup `json:"results,omitempty"`
 }
+
+type DojoGroupsOptions struct {
+	Limit          int
+	Offset         int
+	ID             int
+	Name           string
+	SocialProvider string
+}
+
+func (o *DojoGroupsOptions) ToString() string {
+	if o == nil {
+		return ""
+	}
+
+	v := url.Values{}
+	if o.Limit > 0 {
+		v.Set("limit", strconv.Itoa(o.Limit))
+	}
+	if o.Offset > 0 {
+		v.Set("offset", strconv.Itoa(o.Offset))
+	}
+	if o.ID > 0 {
+		v.Set("id", strconv.Itoa(o.ID))
+	}
+	if len(o.Name) > 0 {
+		v.Set("name", o.Name)
+	}
+	if len(o.SocialProvider) > 0 {
+		v.Set("social_provider", o.SocialProvider)
+	}
+
+	return "?" + v.Encode()
+}
+
+func (c *DojoGroupsService) List(ctx context.Context, options *DojoGroupsOptions) (*DojoGroups, error) {
+	path := fmt.Sprintf("%s/dojo_groups/%s", c.client.BaseURL, options.ToString())
+
+	req, err := http.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := DojoGroups{}
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// All returns an iterator over all dojo groups matching the given options,
+// fetching further pages from DefectDojo as the iteration advances.
+func (c *DojoGroupsService) All(ctx context.Context, options *DojoGroupsOptions) iter.Seq2[DojoGroup, error] {
+	path := fmt.Sprintf("%s/dojo_groups/%s", c.client.BaseURL, options.ToString())
+
+	return all[DojoGroup](ctx, c.client, path)
+}
+
+func (c *DojoGroupsService) Read(ctx context.Context, id int) (*DojoGroup, error) {
+	path := fmt.Sprintf("%s/dojo_groups/%d/", c.client.BaseURL, id)
+
+	req, err := http.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(DojoGroup)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *DojoGroupsService) Create(ctx context.Context, u *DojoGroup) (*DojoGroup, error) {
+	path := fmt.Sprintf("%s/dojo_groups/", c.client.BaseURL)
+
+	postJSON, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPost, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(DojoGroup)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *DojoGroupsService) Update(ctx context.Context, id int, u *DojoGroup) (*DojoGroup, error) {
+	path := fmt.Sprintf("%s/dojo_groups/%d/", c.client.BaseURL, id)
+
+	postJSON, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPut, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(DojoGroup)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *DojoGroupsService) PartialUpdate(ctx context.Context, id int, u *DojoGroup) (*DojoGroup, error) {
+	path := fmt.Sprintf("%s/dojo_groups/%d/", c.client.BaseURL, id)
+
+	postJSON, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPatch, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(DojoGroup)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *DojoGroupsService) Delete(ctx context.Context, id int) (*DojoGroup, error) {
+	path := fmt.Sprintf("%s/dojo_groups/%d/", c.client.BaseURL, id)
+
+	req, err := http.NewRequest(http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(DojoGroup)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
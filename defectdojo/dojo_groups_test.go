@@ -0,0 +1,303 @@
+package defectdojo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDojoGroupsService_List(t *testing.T) {
+	response := `{
+		"count": 1,
+		"next": null,
+		"previous": null,
+		"results": [
+			{
+				"id": 3,
+				"name": "AppSec",
+				"description": "Application security team",
+				"configuration_permissions": [],
+				"social_provider": "AzureAD",
+				"users": [1, 2]
+			}
+		]
+	}`
+
+	expected := DojoGroups{
+		Count:    Int(1),
+		Next:     nil,
+		Previous: nil,
+		Results: &[]DojoGroup{
+			{
+				Id:                       Int(3),
+				Name:                     Str("AppSec"),
+				Description:              Str("Application security team"),
+				ConfigurationPermissions: &[]int{},
+				SocialProvider:           Str("AzureAD"),
+				Users:                    &[]int{1, 2},
+			},
+		},
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected GET request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/dojo_groups/") {
+			t.Errorf("Expected /dojo_groups/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.DojoGroups.List(context.Background(), &DojoGroupsOptions{
+		Limit: 10,
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestDojoGroupsService_Read(t *testing.T) {
+	response := `{
+		"id": 3,
+		"name": "AppSec",
+		"description": "Application security team",
+		"configuration_permissions": [],
+		"social_provider": "AzureAD",
+		"users": [1, 2]
+	}`
+
+	expected := DojoGroup{
+		Id:                       Int(3),
+		Name:                     Str("AppSec"),
+		Description:              Str("Application security team"),
+		ConfigurationPermissions: &[]int{},
+		SocialProvider:           Str("AzureAD"),
+		Users:                    &[]int{1, 2},
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected GET request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/dojo_groups/3/") {
+			t.Errorf("Expected /dojo_groups/3/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.DojoGroups.Read(context.Background(), 3)
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestDojoGroupsService_Create(t *testing.T) {
+	response := `{
+		"id": 3,
+		"name": "AppSec",
+		"description": "Application security team",
+		"configuration_permissions": [],
+		"social_provider": "AzureAD",
+		"users": [1, 2]
+	}`
+
+	expected := DojoGroup{
+		Id:                       Int(3),
+		Name:                     Str("AppSec"),
+		Description:              Str("Application security team"),
+		ConfigurationPermissions: &[]int{},
+		SocialProvider:           Str("AzureAD"),
+		Users:                    &[]int{1, 2},
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("Expected POST request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/dojo_groups/") {
+			t.Errorf("Expected /dojo_groups/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.DojoGroups.Create(context.Background(), &DojoGroup{
+		Name:        Str("AppSec"),
+		Description: Str("Application security team"),
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestDojoGroupsService_Update(t *testing.T) {
+	response := `{
+		"id": 3,
+		"name": "AppSec",
+		"description": "Application security team",
+		"configuration_permissions": [],
+		"social_provider": "AzureAD",
+		"users": [1, 2]
+	}`
+
+	expected := DojoGroup{
+		Id:                       Int(3),
+		Name:                     Str("AppSec"),
+		Description:              Str("Application security team"),
+		ConfigurationPermissions: &[]int{},
+		SocialProvider:           Str("AzureAD"),
+		Users:                    &[]int{1, 2},
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Errorf("Expected PUT request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/dojo_groups/3/") {
+			t.Errorf("Expected /dojo_groups/3/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.DojoGroups.Update(context.Background(), 3, &DojoGroup{
+		Name:        Str("AppSec"),
+		Description: Str("Application security team"),
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestDojoGroupsService_PartialUpdate(t *testing.T) {
+	response := `{
+		"id": 3,
+		"name": "AppSec",
+		"description": "Application security team",
+		"configuration_permissions": [],
+		"social_provider": "AzureAD",
+		"users": [1, 2]
+	}`
+
+	expected := DojoGroup{
+		Id:                       Int(3),
+		Name:                     Str("AppSec"),
+		Description:              Str("Application security team"),
+		ConfigurationPermissions: &[]int{},
+		SocialProvider:           Str("AzureAD"),
+		Users:                    &[]int{1, 2},
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch {
+			t.Errorf("Expected PATCH request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/dojo_groups/3/") {
+			t.Errorf("Expected /dojo_groups/3/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.DojoGroups.PartialUpdate(context.Background(), 3, &DojoGroup{
+		Name:        Str("AppSec"),
+		Description: Str("Application security team"),
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestDojoGroupsService_Delete(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("Expected DELETE request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/dojo_groups/3/") {
+			t.Errorf("Expected /dojo_groups/3/ in path, got %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	_, err := dj.DojoGroups.Delete(context.Background(), 3)
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+}
+
+func TestDojoGroupsOptions_ToString(t *testing.T) {
+	tests := []struct {
+		name     string
+		options  *DojoGroupsOptions
+		expected string
+	}{
+		{
+			name: "name only",
+			options: &DojoGroupsOptions{
+				Name: "AppSec",
+			},
+			expected: "?name=AppSec",
+		},
+		{
+			name: "all fields",
+			options: &DojoGroupsOptions{
+				Limit:          10,
+				Offset:         20,
+				ID:             3,
+				Name:           "App Sec",
+				SocialProvider: "AzureAD",
+			},
+			expected: "?id=3&limit=10&name=App+Sec&offset=20&social_provider=AzureAD",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := tt.options.ToString()
+			if actual != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,226 @@
+package defectdojo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+type EndpointStatusService struct {
+	client *Client
+}
+
+type EndpointStatus struct {
+	Id            *int       `json:"id,omitempty"`
+	Date          *string    `json:"date,omitempty"`
+	LastModified  *time.Time `json:"last_modified,omitempty"`
+	Mitigated     *bool      `json:"mitigated,omitempty"`
+	MitigatedTime *time.Time `json:"mitigated_time,omitempty"`
+	FalsePositive *bool      `json:"false_positive,omitempty"`
+	OutOfScope    *bool      `json:"out_of_scope,omitempty"`
+	RiskAccepted  *bool      `json:"risk_accepted,omitempty"`
+	MitigatedBy   *int       `json:"mitigated_by,omitempty"`
+	Endpoint      *int       `json:"endpoint,omitempty"`
+	Finding       *int       `json:"finding,omitempty"`
+}
+
+type EndpointStatuses struct {
+	Count    *int              `json:"count,omitempty"`
+	Next     *string           `json:"next,omitempty"`
+	Previous *string           `json:"previous,omitempty"`
+	Results  *[]EndpointStatus `json:"results,omitempty"`
+}
+
+type EndpointStatusOptions struct {
+	Limit         int
+	Offset        int
+	ID            int
+	Mitigated     string
+	FalsePositive string
+	OutOfScope    string
+	RiskAccepted  string
+	MitigatedBy   int
+	Endpoint      int
+	Finding       int
+}
+
+func (o *EndpointStatusOptions) ToString() string {
+	if o == nil {
+		return ""
+	}
+
+	v := url.Values{}
+	if o.Limit > 0 {
+		v.Set("limit", strconv.Itoa(o.Limit))
+	}
+	if o.Offset > 0 {
+		v.Set("offset", strconv.Itoa(o.Offset))
+	}
+	if o.ID > 0 {
+		v.Set("id", strconv.Itoa(o.ID))
+	}
+	if len(o.Mitigated) > 0 {
+		v.Set("mitigated", o.Mitigated)
+	}
+	if len(o.FalsePositive) > 0 {
+		v.Set("false_positive", o.FalsePositive)
+	}
+	if len(o.OutOfScope) > 0 {
+		v.Set("out_of_scope", o.OutOfScope)
+	}
+	if len(o.RiskAccepted) > 0 {
+		v.Set("risk_accepted", o.RiskAccepted)
+	}
+	if o.MitigatedBy > 0 {
+		v.Set("mitigated_by", strconv.Itoa(o.MitigatedBy))
+	}
+	if o.Endpoint > 0 {
+		v.Set("endpoint", strconv.Itoa(o.Endpoint))
+	}
+	if o.Finding > 0 {
+		v.Set("finding", strconv.Itoa(o.Finding))
+	}
+
+	return "?" + v.Encode()
+}
+
+func (c *EndpointStatusService) List(ctx context.Context, options *EndpointStatusOptions) (*EndpointStatuses, error) {
+	path := fmt.Sprintf("%s/endpoint_status/%s", c.client.BaseURL, options.ToString())
+
+	req, err := http.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := EndpointStatuses{}
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// All returns an iterator over all endpoint statuses matching the given options,
+// fetching further pages from DefectDojo as the iteration advances.
+func (c *EndpointStatusService) All(ctx context.Context, options *EndpointStatusOptions) iter.Seq2[EndpointStatus, error] {
+	path := fmt.Sprintf("%s/endpoint_status/%s", c.client.BaseURL, options.ToString())
+
+	return all[EndpointStatus](ctx, c.client, path)
+}
+
+func (c *EndpointStatusService) Read(ctx context.Context, id int) (*EndpointStatus, error) {
+	path := fmt.Sprintf("%s/endpoint_status/%d/", c.client.BaseURL, id)
+
+	req, err := http.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(EndpointStatus)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *EndpointStatusService) Create(ctx context.Context, u *EndpointStatus) (*EndpointStatus, error) {
+	path := fmt.Sprintf("%s/endpoint_status/", c.client.BaseURL)
+
+	postJSON, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPost, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(EndpointStatus)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *EndpointStatusService) Update(ctx context.Context, id int, u *EndpointStatus) (*EndpointStatus, error) {
+	path := fmt.Sprintf("%s/endpoint_status/%d/", c.client.BaseURL, id)
+
+	postJSON, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPut, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(EndpointStatus)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *EndpointStatusService) PartialUpdate(ctx context.Context, id int, u *EndpointStatus) (*EndpointStatus, error) {
+	path := fmt.Sprintf("%s/endpoint_status/%d/", c.client.BaseURL, id)
+
+	postJSON, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPatch, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(EndpointStatus)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *EndpointStatusService) Delete(ctx context.Context, id int) (*EndpointStatus, error) {
+	path := fmt.Sprintf("%s/endpoint_status/%d/", c.client.BaseURL, id)
+
+	req, err := http.NewRequest(http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(EndpointStatus)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// Mitigate marks the endpoint status as mitigated, meaning the finding no longer affects the endpoint.
+func (c *EndpointStatusService) Mitigate(ctx context.Context, id int) (*EndpointStatus, error) {
+	return c.PartialUpdate(ctx, id, &EndpointStatus{
+		Mitigated: Bool(true),
+	})
+}
@@ -0,0 +1,350 @@
+package defectdojo
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestEndpointStatusService_List(t *testing.T) {
+	response := `{
+		"count": 1,
+		"next": null,
+		"previous": null,
+		"results": [
+			{
+				"id": 30,
+				"date": "2024-04-02",
+				"mitigated": true,
+				"false_positive": false,
+				"out_of_scope": false,
+				"risk_accepted": false,
+				"endpoint": 14,
+				"finding": 5
+			}
+		]
+	}`
+
+	expected := EndpointStatuses{
+		Count:    Int(1),
+		Next:     nil,
+		Previous: nil,
+		Results: &[]EndpointStatus{
+			{
+				Id:            Int(30),
+				Date:          Str("2024-04-02"),
+				Mitigated:     Bool(true),
+				FalsePositive: Bool(false),
+				OutOfScope:    Bool(false),
+				RiskAccepted:  Bool(false),
+				Endpoint:      Int(14),
+				Finding:       Int(5),
+			},
+		},
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected GET request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/endpoint_status/") {
+			t.Errorf("Expected /endpoint_status/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.EndpointStatus.List(context.Background(), &EndpointStatusOptions{
+		Limit: 10,
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestEndpointStatusService_Read(t *testing.T) {
+	response := `{
+		"id": 30,
+		"date": "2024-04-02",
+		"mitigated": true,
+		"false_positive": false,
+		"out_of_scope": false,
+		"risk_accepted": false,
+		"endpoint": 14,
+		"finding": 5
+	}`
+
+	expected := EndpointStatus{
+		Id:            Int(30),
+		Date:          Str("2024-04-02"),
+		Mitigated:     Bool(true),
+		FalsePositive: Bool(false),
+		OutOfScope:    Bool(false),
+		RiskAccepted:  Bool(false),
+		Endpoint:      Int(14),
+		Finding:       Int(5),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected GET request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/endpoint_status/30/") {
+			t.Errorf("Expected /endpoint_status/30/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.EndpointStatus.Read(context.Background(), 30)
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestEndpointStatusService_Create(t *testing.T) {
+	response := `{
+		"id": 30,
+		"date": "2024-04-02",
+		"mitigated": true,
+		"false_positive": false,
+		"out_of_scope": false,
+		"risk_accepted": false,
+		"endpoint": 14,
+		"finding": 5
+	}`
+
+	expected := EndpointStatus{
+		Id:            Int(30),
+		Date:          Str("2024-04-02"),
+		Mitigated:     Bool(true),
+		FalsePositive: Bool(false),
+		OutOfScope:    Bool(false),
+		RiskAccepted:  Bool(false),
+		Endpoint:      Int(14),
+		Finding:       Int(5),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("Expected POST request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/endpoint_status/") {
+			t.Errorf("Expected /endpoint_status/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.EndpointStatus.Create(context.Background(), &EndpointStatus{
+		Mitigated: Bool(true),
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestEndpointStatusService_Update(t *testing.T) {
+	response := `{
+		"id": 30,
+		"date": "2024-04-02",
+		"mitigated": true,
+		"false_positive": false,
+		"out_of_scope": false,
+		"risk_accepted": false,
+		"endpoint": 14,
+		"finding": 5
+	}`
+
+	expected := EndpointStatus{
+		Id:            Int(30),
+		Date:          Str("2024-04-02"),
+		Mitigated:     Bool(true),
+		FalsePositive: Bool(false),
+		OutOfScope:    Bool(false),
+		RiskAccepted:  Bool(false),
+		Endpoint:      Int(14),
+		Finding:       Int(5),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Errorf("Expected PUT request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/endpoint_status/30/") {
+			t.Errorf("Expected /endpoint_status/30/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.EndpointStatus.Update(context.Background(), 30, &EndpointStatus{
+		Mitigated: Bool(true),
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestEndpointStatusService_PartialUpdate(t *testing.T) {
+	response := `{
+		"id": 30,
+		"date": "2024-04-02",
+		"mitigated": true,
+		"false_positive": false,
+		"out_of_scope": false,
+		"risk_accepted": false,
+		"endpoint": 14,
+		"finding": 5
+	}`
+
+	expected := EndpointStatus{
+		Id:            Int(30),
+		Date:          Str("2024-04-02"),
+		Mitigated:     Bool(true),
+		FalsePositive: Bool(false),
+		OutOfScope:    Bool(false),
+		RiskAccepted:  Bool(false),
+		Endpoint:      Int(14),
+		Finding:       Int(5),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch {
+			t.Errorf("Expected PATCH request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/endpoint_status/30/") {
+			t.Errorf("Expected /endpoint_status/30/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.EndpointStatus.PartialUpdate(context.Background(), 30, &EndpointStatus{
+		Mitigated: Bool(true),
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestEndpointStatusService_Delete(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("Expected DELETE request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/endpoint_status/30/") {
+			t.Errorf("Expected /endpoint_status/30/ in path, got %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	_, err := dj.EndpointStatus.Delete(context.Background(), 30)
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+}
+
+func TestEndpointStatusOptions_ToString(t *testing.T) {
+	tests := []struct {
+		name     string
+		options  *EndpointStatusOptions
+		expected string
+	}{
+		{
+			name: "finding only",
+			options: &EndpointStatusOptions{
+				Finding: 5,
+			},
+			expected: "?finding=5",
+		},
+		{
+			name: "all fields",
+			options: &EndpointStatusOptions{
+				Limit:     10,
+				Offset:    20,
+				Mitigated: "false",
+				Endpoint:  14,
+				Finding:   5,
+			},
+			expected: "?endpoint=14&finding=5&limit=10&mitigated=false&offset=20",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := tt.options.ToString()
+			if actual != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestEndpointStatusService_Mitigate(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch {
+			t.Errorf("Expected PATCH request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/endpoint_status/30/") {
+			t.Errorf("Expected /endpoint_status/30/ in path, got %s", r.URL.Path)
+		}
+		b, _ := io.ReadAll(r.Body)
+		if string(b) != `{"mitigated":true}` {
+			t.Errorf("unexpected body %s", b)
+		}
+		_, _ = fmt.Fprintln(w, `{"id": 30, "mitigated": true}`)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.EndpointStatus.Mitigate(context.Background(), 30)
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	expected := &EndpointStatus{Id: Int(30), Mitigated: Bool(true)}
+	if !cmp.Equal(actual, expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, expected)
+	}
+}
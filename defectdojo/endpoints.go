@@ -0,0 +1,273 @@
+package defectdojo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+type EndpointsService struct {
+	client *Client
+}
+
+type Endpoint struct {
+	Id             *int      `json:"id,omitempty"`
+	Tags           *[]string `json:"tags,omitempty"`
+	Protocol       *string   `json:"protocol,omitempty"`
+	Userinfo       *string   `json:"userinfo,omitempty"`
+	Host           *string   `json:"host,omitempty"`
+	Port           *int      `json:"port,omitempty"`
+	Path           *string   `json:"path,omitempty"`
+	Query          *string   `json:"query,omitempty"`
+	Fragment       *string   `json:"fragment,omitempty"`
+	Product        *int      `json:"product,omitempty"`
+	EndpointParams *[]int    `json:"endpoint_params,omitempty"`
+	Findings       *[]int    `json:"findings,omitempty"`
+}
+
+type Endpoints struct {
+	Count    *int        `json:"count,omitempty"`
+	Next     *string     `json:"next,omitempty"`
+	Previous *string     `json:"previous,omitempty"`
+	Results  *[]Endpoint `json:"results,omitempty"`
+}
+
+type EndpointsOptions struct {
+	Limit    int
+	Offset   int
+	ID       int
+	Protocol string
+	Userinfo string
+	Host     string
+	Port     int
+	Path     string
+	Query    string
+	Fragment string
+	Product  int
+	Tags     []string
+	Ordering string
+}
+
+func (o *EndpointsOptions) ToString() string {
+	if o == nil {
+		return ""
+	}
+
+	v := url.Values{}
+	if o.Limit > 0 {
+		v.Set("limit", strconv.Itoa(o.Limit))
+	}
+	if o.Offset > 0 {
+		v.Set("offset", strconv.Itoa(o.Offset))
+	}
+	if o.ID > 0 {
+		v.Set("id", strconv.Itoa(o.ID))
+	}
+	if len(o.Protocol) > 0 {
+		v.Set("protocol", o.Protocol)
+	}
+	if len(o.Userinfo) > 0 {
+		v.Set("userinfo", o.Userinfo)
+	}
+	if len(o.Host) > 0 {
+		v.Set("host", o.Host)
+	}
+	if o.Port > 0 {
+		v.Set("port", strconv.Itoa(o.Port))
+	}
+	if len(o.Path) > 0 {
+		v.Set("path", o.Path)
+	}
+	if len(o.Query) > 0 {
+		v.Set("query", o.Query)
+	}
+	if len(o.Fragment) > 0 {
+		v.Set("fragment", o.Fragment)
+	}
+	if o.Product > 0 {
+		v.Set("product", strconv.Itoa(o.Product))
+	}
+	if len(o.Tags) > 0 {
+		v.Set("tags", strings.Join(o.Tags, ","))
+	}
+	if len(o.Ordering) > 0 {
+		v.Set("o", o.Ordering)
+	}
+
+	return "?" + v.Encode()
+}
+
+func (c *EndpointsService) List(ctx context.Context, options *EndpointsOptions) (*Endpoints, error) {
+	path := fmt.Sprintf("%s/endpoints/%s", c.client.BaseURL, options.ToString())
+
+	req, err := http.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := Endpoints{}
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// All returns an iterator over all endpoints matching the given options,
+// fetching further pages from DefectDojo as the iteration advances.
+func (c *EndpointsService) All(ctx context.Context, options *EndpointsOptions) iter.Seq2[Endpoint, error] {
+	path := fmt.Sprintf("%s/endpoints/%s", c.client.BaseURL, options.ToString())
+
+	return all[Endpoint](ctx, c.client, path)
+}
+
+func (c *EndpointsService) Read(ctx context.Context, id int) (*Endpoint, error) {
+	path := fmt.Sprintf("%s/endpoints/%d/", c.client.BaseURL, id)
+
+	req, err := http.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(Endpoint)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *EndpointsService) Create(ctx context.Context, u *Endpoint) (*Endpoint, error) {
+	path := fmt.Sprintf("%s/endpoints/", c.client.BaseURL)
+
+	postJSON, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPost, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(Endpoint)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *EndpointsService) Update(ctx context.Context, id int, u *Endpoint) (*Endpoint, error) {
+	path := fmt.Sprintf("%s/endpoints/%d/", c.client.BaseURL, id)
+
+	postJSON, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPut, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(Endpoint)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *EndpointsService) PartialUpdate(ctx context.Context, id int, u *Endpoint) (*Endpoint, error) {
+	path := fmt.Sprintf("%s/endpoints/%d/", c.client.BaseURL, id)
+
+	postJSON, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPatch, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(Endpoint)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *EndpointsService) Delete(ctx context.Context, id int) (*Endpoint, error) {
+	path := fmt.Sprintf("%s/endpoints/%d/", c.client.BaseURL, id)
+
+	req, err := http.NewRequest(http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(Endpoint)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// EndpointFromURL builds an Endpoint from the components of u.
+// The port is only set if u specifies one, and the path is stored without its leading slash,
+// as DefectDojo does.
+func EndpointFromURL(u *url.URL) (*Endpoint, error) {
+	if u == nil {
+		return nil, errors.New("EndpointFromURL: URL is nil")
+	}
+	if len(u.Hostname()) == 0 {
+		return nil, fmt.Errorf("EndpointFromURL: URL %q has no host", u.String())
+	}
+
+	e := &Endpoint{
+		Host: Str(u.Hostname()),
+	}
+	if len(u.Scheme) > 0 {
+		e.Protocol = Str(u.Scheme)
+	}
+	if u.User != nil {
+		e.Userinfo = Str(u.User.String())
+	}
+	if p := u.Port(); len(p) > 0 {
+		port, err := strconv.Atoi(p)
+		if err != nil {
+			return nil, fmt.Errorf("EndpointFromURL: invalid port %q: %w", p, err)
+		}
+		e.Port = Int(port)
+	}
+	if path := strings.TrimPrefix(u.EscapedPath(), "/"); len(path) > 0 {
+		e.Path = Str(path)
+	}
+	if len(u.RawQuery) > 0 {
+		e.Query = Str(u.RawQuery)
+	}
+	if len(u.Fragment) > 0 {
+		e.Fragment = Str(u.EscapedFragment())
+	}
+
+	return e, nil
+}
@@ -5,6 +5,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
+	"iter"
 	"net/http"
 	"strings"
 )
@@ -14,49 +16,45 @@ type EngagementsService struct {
 }
 
 type Engagement struct {
-	Id                         *int      `json:"id,omitempty"`
-	Tags                       *[]string `json:"tags,omitempty"`
-	Name                       *string   `json:"name,omitempty"`
-	Description                *string   `json:"description,omitempty"`
-	Version                    *string   `json:"version,omitempty"`
-	FirstContacted             *string   `json:"first_contacted,omitempty"`
-	TargetStart                *string   `json:"target_start,omitempty"`
-	TargetEnd                  *string   `json:"target_end,omitempty"`
-	Reason                     *string   `json:"reason,omitempty"`
-	Updated                    *string   `json:"updated,omitempty"`
-	Created                    *string   `json:"created,omitempty"`
-	Active                     *bool     `json:"active,omitempty"`
-	Tracker                    *string   `json:"tracker,omitempty"`
-	TestStrategy               *string   `json:"test_strategy,omitempty"`
-	ThreatModel                *bool     `json:"threat_model,omitempty"`
-	ApiTest                    *bool     `json:"api_test,omitempty"`
-	PenTest                    *bool     `json:"pen_test,omitempty"`
-	CheckList                  *bool     `json:"check_list,omitempty"`
-	Status                     *string   `json:"status,omitempty"`
-	Progress                   *string   `json:"progress,omitempty"`
-	TmodelPath                 *string   `json:"tmodel_path,omitempty"`
-	DoneTesting                *bool     `json:"done_testing,omitempty"`
-	EngagementType             *string   `json:"engagement_type,omitempty"`
-	BuildId                    *string   `json:"build_id,omitempty"`
-	CommitHash                 *string   `json:"commit_hash,omitempty"`
-	BranchTag                  *string   `json:"branch_tag,omitempty"`
-	SourceCodeManagementUri    *string   `json:"source_code_management_uri,omitempty"`
-	DeduplicationOnEngagement  *bool     `json:"deduplication_on_engagement,omitempty"`
-	Lead                       *int      `json:"lead,omitempty"`
-	Requester                  *int      `json:"requester,omitempty"`
-	Preset                     *int      `json:"preset,omitempty"`
-	ReportType                 *int      `json:"report_type,omitempty"`
-	Product                    *int      `json:"product,omitempty"`
-	BuildServer                *int      `json:"build_server,omitempty"`
-	SourceCodeManagementServer *int      `json:"source_code_management_server,omitempty"`
-	OrchestrationEngine        *int      `json:"orchestration_engine,omitempty"`
-	Notes                      *[]Note   `json:"notes,omitempty"`
-	Files                      *[]struct {
-		Id    *int    `json:"id,omitempty"`
-		File  *string `json:"file,omitempty"`
-		Title *string `json:"title,omitempty"`
-	} `json:"files,omitempty"`
-	RiskAcceptance *[]int `json:"risk_acceptance,omitempty"`
+	Id                         *int              `json:"id,omitempty"`
+	Tags                       *[]string         `json:"tags,omitempty"`
+	Name                       *string           `json:"name,omitempty"`
+	Description                *string           `json:"description,omitempty"`
+	Version                    *string           `json:"version,omitempty"`
+	FirstContacted             *string           `json:"first_contacted,omitempty"`
+	TargetStart                *string           `json:"target_start,omitempty"`
+	TargetEnd                  *string           `json:"target_end,omitempty"`
+	Reason                     *string           `json:"reason,omitempty"`
+	Updated                    *string           `json:"updated,omitempty"`
+	Created                    *string           `json:"created,omitempty"`
+	Active                     *bool             `json:"active,omitempty"`
+	Tracker                    *string           `json:"tracker,omitempty"`
+	TestStrategy               *string           `json:"test_strategy,omitempty"`
+	ThreatModel                *bool             `json:"threat_model,omitempty"`
+	ApiTest                    *bool             `json:"api_test,omitempty"`
+	PenTest                    *bool             `json:"pen_test,omitempty"`
+	CheckList                  *bool             `json:"check_list,omitempty"`
+	Status                     *EngagementStatus `json:"status,omitempty"`
+	Progress                   *string           `json:"progress,omitempty"`
+	TmodelPath                 *string           `json:"tmodel_path,omitempty"`
+	DoneTesting                *bool             `json:"done_testing,omitempty"`
+	EngagementType             *EngagementType   `json:"engagement_type,omitempty"`
+	BuildId                    *string           `json:"build_id,omitempty"`
+	CommitHash                 *string           `json:"commit_hash,omitempty"`
+	BranchTag                  *string           `json:"branch_tag,omitempty"`
+	SourceCodeManagementUri    *string           `json:"source_code_management_uri,omitempty"`
+	DeduplicationOnEngagement  *bool             `json:"deduplication_on_engagement,omitempty"`
+	Lead                       *int              `json:"lead,omitempty"`
+	Requester                  *int              `json:"requester,omitempty"`
+	Preset                     *int              `json:"preset,omitempty"`
+	ReportType                 *int              `json:"report_type,omitempty"`
+	Product                    *int              `json:"product,omitempty"`
+	BuildServer                *int              `json:"build_server,omitempty"`
+	SourceCodeManagementServer *int              `json:"source_code_management_server,omitempty"`
+	OrchestrationEngine        *int              `json:"orchestration_engine,omitempty"`
+	Notes                      *[]Note           `json:"notes,omitempty"`
+	Files                      *[]File           `json:"files,omitempty"`
+	RiskAcceptance             *[]int            `json:"risk_acceptance,omitempty"`
 }
 
 type Engagements struct {
@@ -113,6 +111,14 @@ func (c *EngagementsService) List(ctx context.Context, options *EngagementsOptio
 	return &res, nil
 }
 
+// All returns an iterator over all engagements matching the given options,
+// fetching further pages from DefectDojo as the iteration advances.
+func (c *EngagementsService) All(ctx context.Context, options *EngagementsOptions) iter.Seq2[Engagement, error] {
+	path := fmt.Sprintf("%s/engagements/%s", c.client.BaseURL, options.ToString())
+
+	return all[Engagement](ctx, c.client, path)
+}
+
 func (c *EngagementsService) Read(ctx context.Context, id int) (*Engagement, error) {
 	path := fmt.Sprintf("%s/engagements/%d/", c.client.BaseURL, id)
 
@@ -152,3 +158,233 @@ func (c *EngagementsService) Create(ctx context.Context, u *Engagement) (*Engage
 
 	return res, nil
 }
+
+func (c *EngagementsService) Update(ctx context.Context, id int, u *Engagement) (*Engagement, error) {
+	path := fmt.Sprintf("%s/engagements/%d/", c.client.BaseURL, id)
+
+	postJSON, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPut, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(Engagement)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *EngagementsService) PartialUpdate(ctx context.Context, id int, u *Engagement) (*Engagement, error) {
+	path := fmt.Sprintf("%s/engagements/%d/", c.client.BaseURL, id)
+
+	postJSON, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPatch, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(Engagement)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *EngagementsService) Delete(ctx context.Context, id int) (*Engagement, error) {
+	path := fmt.Sprintf("%s/engagements/%d/", c.client.BaseURL, id)
+
+	req, err := http.NewRequest(http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(Engagement)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// Close closes the engagement, marking it as completed.
+func (c *EngagementsService) Close(ctx context.Context, id int) error {
+	path := fmt.Sprintf("%s/engagements/%d/close/", c.client.BaseURL, id)
+
+	req, err := http.NewRequest(http.MethodPost, path, nil)
+	if err != nil {
+		return err
+	}
+
+	req = req.WithContext(ctx)
+
+	return c.client.sendRequest(req, nil)
+}
+
+// Reopen reopens a closed engagement.
+func (c *EngagementsService) Reopen(ctx context.Context, id int) error {
+	path := fmt.Sprintf("%s/engagements/%d/reopen/", c.client.BaseURL, id)
+
+	req, err := http.NewRequest(http.MethodPost, path, nil)
+	if err != nil {
+		return err
+	}
+
+	req = req.WithContext(ctx)
+
+	return c.client.sendRequest(req, nil)
+}
+
+// EngagementNotes holds the notes of an engagement.
+type EngagementNotes struct {
+	EngagementId *int    `json:"engagement_id,omitempty"`
+	Notes        *[]Note `json:"notes,omitempty"`
+}
+
+// UnmarshalJSON also accepts the bare empty list DefectDojo returns for an engagement without notes.
+func (n *EngagementNotes) UnmarshalJSON(b []byte) error {
+	if b = bytes.TrimSpace(b); len(b) > 0 && b[0] == '[' {
+		var notes []Note
+		if err := json.Unmarshal(b, &notes); err != nil {
+			return err
+		}
+		*n = EngagementNotes{Notes: &notes}
+		return nil
+	}
+
+	type engagementNotes EngagementNotes
+	return json.Unmarshal(b, (*engagementNotes)(n))
+}
+
+// ListNotes returns the notes of the engagement.
+func (c *EngagementsService) ListNotes(ctx context.Context, id int) (*EngagementNotes, error) {
+	path := fmt.Sprintf("%s/engagements/%d/notes/", c.client.BaseURL, id)
+
+	req, err := http.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(EngagementNotes)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// AddNote adds a note to the engagement.
+func (c *EngagementsService) AddNote(ctx context.Context, id int, u *NewNote) (*Note, error) {
+	path := fmt.Sprintf("%s/engagements/%d/notes/", c.client.BaseURL, id)
+
+	postJSON, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPost, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(Note)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// ListFiles returns the files attached to the engagement.
+func (c *EngagementsService) ListFiles(ctx context.Context, id int) (*Files, error) {
+	path := fmt.Sprintf("%s/engagements/%d/files/", c.client.BaseURL, id)
+
+	req, err := http.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(Files)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// AddFile uploads a file with the given title and attaches it to the engagement.
+func (c *EngagementsService) AddFile(ctx context.Context, id int, title string, upload *FileUpload) (*File, error) {
+	path := fmt.Sprintf("%s/engagements/%d/files/", c.client.BaseURL, id)
+
+	req, err := newFileUploadRequest(path, &importScanMap{"title": title}, upload)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(File)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// DownloadFile writes the contents of the file with ID fileID attached to the engagement to w.
+func (c *EngagementsService) DownloadFile(ctx context.Context, id int, fileID int, w io.Writer) error {
+	path := fmt.Sprintf("%s/engagements/%d/files/download/%d/", c.client.BaseURL, id, fileID)
+
+	req, err := http.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return err
+	}
+
+	req = req.WithContext(ctx)
+
+	return c.client.sendRequest(req, w)
+}
+
+// AddTags adds tags to the engagement, keeping its existing tags.
+// DefectDojo has no dedicated tags action for engagements, so the tags are read and
+// written back with a partial update.
+func (c *EngagementsService) AddTags(ctx context.Context, id int, tags []string) (*Engagement, error) {
+	e, err := c.Read(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	merged := mergeUnique(e.Tags, tags)
+	return c.PartialUpdate(ctx, id, &Engagement{Tags: &merged})
+}
+
+// RemoveTags removes tags from the engagement.
+// Like AddTags, it reads the current tags and writes the remaining ones back with a partial update.
+func (c *EngagementsService) RemoveTags(ctx context.Context, id int, tags []string) (*Engagement, error) {
+	e, err := c.Read(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	remaining := without(e.Tags, tags)
+	return c.PartialUpdate(ctx, id, &Engagement{Tags: &remaining})
+}
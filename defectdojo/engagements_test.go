@@ -2,6 +2,7 @@ package defectdojo
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -48,8 +49,8 @@ func TestEngagementsService_List(t *testing.T) {
 				TargetStart:    Str("2022-01-01"),
 				TargetEnd:      Str("2022-03-31"),
 				Active:         Bool(true),
-				Status:         Str("In Progress"),
-				EngagementType: Str("Interactive"),
+				Status:         Ptr(EngagementStatusInProgress),
+				EngagementType: Ptr(EngagementTypeInteractive),
 				Lead:           Int(1),
 				Product:        Int(1),
 			},
@@ -107,8 +108,8 @@ func TestEngagementsService_Read(t *testing.T) {
 		TargetStart:    Str("2022-06-01"),
 		TargetEnd:      Str("2022-06-30"),
 		Active:         Bool(true),
-		Status:         Str("In Progress"),
-		EngagementType: Str("CI/CD"),
+		Status:         Ptr(EngagementStatusInProgress),
+		EngagementType: Ptr(EngagementTypeCICD),
 		Lead:           Int(2),
 		Product:        Int(3),
 	}
@@ -158,8 +159,8 @@ func TestEngagementsService_Create(t *testing.T) {
 		TargetStart:    Str("2022-07-01"),
 		TargetEnd:      Str("2022-07-31"),
 		Active:         Bool(true),
-		Status:         Str("Not Started"),
-		EngagementType: Str("CI/CD"),
+		Status:         Ptr(EngagementStatusNotStarted),
+		EngagementType: Ptr(EngagementTypeCICD),
 		Product:        Int(1),
 	}
 
@@ -182,7 +183,7 @@ func TestEngagementsService_Create(t *testing.T) {
 		Description:    Str("Automated CI/CD scan"),
 		TargetStart:    Str("2022-07-01"),
 		TargetEnd:      Str("2022-07-31"),
-		EngagementType: Str("CI/CD"),
+		EngagementType: Ptr(EngagementTypeCICD),
 		Product:        Int(1),
 	})
 	if !cmp.Equal(err, nil) {
@@ -194,6 +195,262 @@ func TestEngagementsService_Create(t *testing.T) {
 	}
 }
 
+func TestEngagementsService_Update(t *testing.T) {
+	response := `{
+		"id": 123,
+		"name": "CI build 42",
+		"target_start": "2024-06-01",
+		"target_end": "2024-06-30",
+		"active": true,
+		"status": "In Progress",
+		"engagement_type": "CI/CD",
+		"product": 3
+	}`
+
+	expected := Engagement{
+		Id:             Int(123),
+		Name:           Str("CI build 42"),
+		TargetStart:    Str("2024-06-01"),
+		TargetEnd:      Str("2024-06-30"),
+		Active:         Bool(true),
+		Status:         Ptr(EngagementStatusInProgress),
+		EngagementType: Ptr(EngagementTypeCICD),
+		Product:        Int(3),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Errorf("Expected PUT request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/engagements/123/") {
+			t.Errorf("Expected /engagements/123/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.Engagements.Update(context.Background(), 123, &Engagement{
+		TargetEnd: Str("2024-06-30"),
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestEngagementsService_PartialUpdate(t *testing.T) {
+	response := `{
+		"id": 123,
+		"name": "CI build 42",
+		"target_start": "2024-06-01",
+		"target_end": "2024-06-30",
+		"active": true,
+		"status": "In Progress",
+		"engagement_type": "CI/CD",
+		"product": 3
+	}`
+
+	expected := Engagement{
+		Id:             Int(123),
+		Name:           Str("CI build 42"),
+		TargetStart:    Str("2024-06-01"),
+		TargetEnd:      Str("2024-06-30"),
+		Active:         Bool(true),
+		Status:         Ptr(EngagementStatusInProgress),
+		EngagementType: Ptr(EngagementTypeCICD),
+		Product:        Int(3),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch {
+			t.Errorf("Expected PATCH request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/engagements/123/") {
+			t.Errorf("Expected /engagements/123/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.Engagements.PartialUpdate(context.Background(), 123, &Engagement{
+		TargetEnd: Str("2024-06-30"),
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestEngagementsService_Delete(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("Expected DELETE request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/engagements/123/") {
+			t.Errorf("Expected /engagements/123/ in path, got %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	_, err := dj.Engagements.Delete(context.Background(), 123)
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+}
+
+func TestEngagementsService_CloseReopen(t *testing.T) {
+	var paths []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("Expected POST request, got %s", r.Method)
+		}
+		paths = append(paths, r.URL.Path)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	if err := dj.Engagements.Close(context.Background(), 123); !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+	if err := dj.Engagements.Reopen(context.Background(), 123); !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	expected := []string{"/api/v2/engagements/123/close/", "/api/v2/engagements/123/reopen/"}
+	if !cmp.Equal(paths, expected) {
+		t.Errorf("should have been equal, %v, %v", paths, expected)
+	}
+}
+
+func TestEngagementsService_ListNotes(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		expected *EngagementNotes
+	}{
+		{
+			name: "with notes",
+			body: `{"engagement_id": 123, "notes": [{"id": 8, "entry": "Build passed", "private": false}]}`,
+			expected: &EngagementNotes{
+				EngagementId: Int(123),
+				Notes:        &[]Note{{Id: Int(8), Entry: Str("Build passed"), Private: Bool(false)}},
+			},
+		},
+		{
+			name:     "without notes",
+			body:     `[]`,
+			expected: &EngagementNotes{Notes: &[]Note{}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodGet {
+					t.Errorf("Expected GET request, got %s", r.Method)
+				}
+				if !strings.Contains(r.URL.Path, "/engagements/123/notes/") {
+					t.Errorf("Expected /engagements/123/notes/ in path, got %s", r.URL.Path)
+				}
+				_, _ = fmt.Fprintln(w, tt.body)
+			}))
+			defer ts.Close()
+
+			dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+			actual, err := dj.Engagements.ListNotes(context.Background(), 123)
+			if !cmp.Equal(err, nil) {
+				t.Errorf("error: %s", err)
+			}
+			if !cmp.Equal(actual, tt.expected) {
+				t.Errorf("should have been equal, %+v, %+v", actual, tt.expected)
+			}
+		})
+	}
+}
+
+func TestEngagementsService_AddNote(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("Expected POST request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/engagements/123/notes/") {
+			t.Errorf("Expected /engagements/123/notes/ in path, got %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusCreated)
+		_, _ = fmt.Fprintln(w, `{"id": 8, "entry": "Build passed", "private": false}`)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.Engagements.AddNote(context.Background(), 123, &NewNote{Entry: Str("Build passed")})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	expected := &Note{Id: Int(8), Entry: Str("Build passed"), Private: Bool(false)}
+	if !cmp.Equal(actual, expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, expected)
+	}
+}
+
+func TestEngagementsService_Tags(t *testing.T) {
+	tags := []string{"release", "ci"}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.Contains(r.URL.Path, "/engagements/123/") {
+			t.Errorf("Expected /engagements/123/ in path, got %s", r.URL.Path)
+		}
+		switch r.Method {
+		case http.MethodGet:
+		case http.MethodPatch:
+			var body Engagement
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("cannot decode body: %s", err)
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			tags = *body.Tags
+		default:
+			t.Errorf("unexpected %s request", r.Method)
+		}
+		_ = json.NewEncoder(w).Encode(&Engagement{Id: Int(123), Tags: &tags})
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.Engagements.AddTags(context.Background(), 123, []string{"ci", "v1.2.0"})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+	if !cmp.Equal(*actual.Tags, []string{"release", "ci", "v1.2.0"}) {
+		t.Errorf("unexpected tags %v", *actual.Tags)
+	}
+
+	actual, err = dj.Engagements.RemoveTags(context.Background(), 123, []string{"release"})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+	if !cmp.Equal(*actual.Tags, []string{"ci", "v1.2.0"}) {
+		t.Errorf("unexpected tags %v", *actual.Tags)
+	}
+}
+
 func TestEngagementsOptions_ToString(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -0,0 +1,218 @@
+package defectdojo
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+)
+
+// validator is implemented by the enumerated types, such as Severity or RoleID, so that requests
+// which are not encoded with encoding/json, such as scan imports, can validate them too.
+type validator interface {
+	Validate() error
+}
+
+// Severity is the severity of a finding.
+type Severity string
+
+const (
+	SeverityInfo     Severity = "Info"
+	SeverityLow      Severity = "Low"
+	SeverityMedium   Severity = "Medium"
+	SeverityHigh     Severity = "High"
+	SeverityCritical Severity = "Critical"
+)
+
+var severities = []Severity{SeverityInfo, SeverityLow, SeverityMedium, SeverityHigh, SeverityCritical}
+
+// Validate returns an error if s is not one of the severities known to DefectDojo.
+func (s Severity) Validate() error {
+	if !slices.Contains(severities, s) {
+		return fmt.Errorf("invalid severity %q", string(s))
+	}
+	return nil
+}
+
+func (s Severity) MarshalJSON() ([]byte, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(s))
+}
+
+// RoleID identifies one of the predefined roles granted through memberships and global roles.
+type RoleID int
+
+const (
+	RoleAPIImporter RoleID = 1
+	RoleWriter      RoleID = 2
+	RoleMaintainer  RoleID = 3
+	RoleOwner       RoleID = 4
+	RoleReader      RoleID = 5
+)
+
+var roleNames = map[RoleID]string{
+	RoleAPIImporter: "API Importer",
+	RoleWriter:      "Writer",
+	RoleMaintainer:  "Maintainer",
+	RoleOwner:       "Owner",
+	RoleReader:      "Reader",
+}
+
+// String returns the name DefectDojo displays for the role.
+func (r RoleID) String() string {
+	if name, ok := roleNames[r]; ok {
+		return name
+	}
+	return fmt.Sprintf("RoleID(%d)", int(r))
+}
+
+// Validate returns an error if r is not one of the roles known to DefectDojo.
+func (r RoleID) Validate() error {
+	if _, ok := roleNames[r]; !ok {
+		return fmt.Errorf("invalid role %d", int(r))
+	}
+	return nil
+}
+
+func (r RoleID) MarshalJSON() ([]byte, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+	return json.Marshal(int(r))
+}
+
+// EngagementStatus is the status of an engagement.
+type EngagementStatus string
+
+const (
+	EngagementStatusNotStarted         EngagementStatus = "Not Started"
+	EngagementStatusBlocked            EngagementStatus = "Blocked"
+	EngagementStatusCancelled          EngagementStatus = "Cancelled"
+	EngagementStatusCompleted          EngagementStatus = "Completed"
+	EngagementStatusInProgress         EngagementStatus = "In Progress"
+	EngagementStatusOnHold             EngagementStatus = "On Hold"
+	EngagementStatusScheduled          EngagementStatus = "Scheduled"
+	EngagementStatusWaitingForResource EngagementStatus = "Waiting for Resource"
+)
+
+var engagementStatuses = []EngagementStatus{
+	EngagementStatusNotStarted,
+	EngagementStatusBlocked,
+	EngagementStatusCancelled,
+	EngagementStatusCompleted,
+	EngagementStatusInProgress,
+	EngagementStatusOnHold,
+	EngagementStatusScheduled,
+	EngagementStatusWaitingForResource,
+}
+
+// Validate returns an error if s is not one of the engagement statuses known to DefectDojo.
+func (s EngagementStatus) Validate() error {
+	if !slices.Contains(engagementStatuses, s) {
+		return fmt.Errorf("invalid engagement status %q", string(s))
+	}
+	return nil
+}
+
+func (s EngagementStatus) MarshalJSON() ([]byte, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(s))
+}
+
+// EngagementType tells interactive engagements apart from the ones fed by CI/CD pipelines.
+type EngagementType string
+
+const (
+	EngagementTypeInteractive EngagementType = "Interactive"
+	EngagementTypeCICD        EngagementType = "CI/CD"
+)
+
+// Validate returns an error if t is not one of the engagement types known to DefectDojo.
+func (t EngagementType) Validate() error {
+	if t != EngagementTypeInteractive && t != EngagementTypeCICD {
+		return fmt.Errorf("invalid engagement type %q", string(t))
+	}
+	return nil
+}
+
+func (t EngagementType) MarshalJSON() ([]byte, error) {
+	if err := t.Validate(); err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(t))
+}
+
+// BusinessCriticality is the importance of a product to the business.
+type BusinessCriticality string
+
+const (
+	BusinessCriticalityVeryHigh BusinessCriticality = "very high"
+	BusinessCriticalityHigh     BusinessCriticality = "high"
+	BusinessCriticalityMedium   BusinessCriticality = "medium"
+	BusinessCriticalityLow      BusinessCriticality = "low"
+	BusinessCriticalityVeryLow  BusinessCriticality = "very low"
+	BusinessCriticalityNone     BusinessCriticality = "none"
+)
+
+var businessCriticalities = []BusinessCriticality{
+	BusinessCriticalityVeryHigh,
+	BusinessCriticalityHigh,
+	BusinessCriticalityMedium,
+	BusinessCriticalityLow,
+	BusinessCriticalityVeryLow,
+	BusinessCriticalityNone,
+}
+
+// Validate returns an error if b is not one of the business criticalities known to DefectDojo.
+func (b BusinessCriticality) Validate() error {
+	if !slices.Contains(businessCriticalities, b) {
+		return fmt.Errorf("invalid business criticality %q", string(b))
+	}
+	return nil
+}
+
+func (b BusinessCriticality) MarshalJSON() ([]byte, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(b))
+}
+
+// RegulationCategory groups regulations by the field they apply to.
+type RegulationCategory string
+
+const (
+	RegulationCategoryPrivacy   RegulationCategory = "privacy"
+	RegulationCategoryFinance   RegulationCategory = "finance"
+	RegulationCategoryEducation RegulationCategory = "education"
+	RegulationCategoryMedical   RegulationCategory = "medical"
+	RegulationCategoryCorporate RegulationCategory = "corporate"
+	RegulationCategoryOther     RegulationCategory = "other"
+)
+
+var regulationCategories = []RegulationCategory{
+	RegulationCategoryPrivacy,
+	RegulationCategoryFinance,
+	RegulationCategoryEducation,
+	RegulationCategoryMedical,
+	RegulationCategoryCorporate,
+	RegulationCategoryOther,
+}
+
+// Validate returns an error if c is not one of the regulation categories known to DefectDojo.
+func (c RegulationCategory) Validate() error {
+	if !slices.Contains(regulationCategories, c) {
+		return fmt.Errorf("invalid regulation category %q", string(c))
+	}
+	return nil
+}
+
+func (c RegulationCategory) MarshalJSON() ([]byte, error) {
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(c))
+}
@@ -0,0 +1,98 @@
+package defectdojo
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestEnums_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    interface{}
+		expected string
+		wantErr  bool
+	}{
+		{name: "severity", value: SeverityCritical, expected: `"Critical"`},
+		{name: "lower case severity", value: Severity("critical"), wantErr: true},
+		{name: "role", value: RoleOwner, expected: `4`},
+		{name: "unknown role", value: RoleID(42), wantErr: true},
+		{name: "engagement status", value: EngagementStatusInProgress, expected: `"In Progress"`},
+		{name: "unknown engagement status", value: EngagementStatus("Done"), wantErr: true},
+		{name: "engagement type", value: EngagementTypeCICD, expected: `"CI/CD"`},
+		{name: "unknown engagement type", value: EngagementType("CICD"), wantErr: true},
+		{name: "business criticality", value: BusinessCriticalityVeryHigh, expected: `"very high"`},
+		{name: "unknown business criticality", value: BusinessCriticality("Very High"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := json.Marshal(tt.value)
+			if tt.wantErr {
+				if cmp.Equal(err, nil) {
+					t.Errorf("expected an error, got %s", actual)
+				}
+				return
+			}
+			if !cmp.Equal(err, nil) {
+				t.Fatalf("error: %s", err)
+			}
+			if string(actual) != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestEnums_UnmarshalJSON(t *testing.T) {
+	var actual Finding
+	if err := json.Unmarshal([]byte(`{"severity": "High"}`), &actual); err != nil {
+		t.Fatalf("error: %s", err)
+	}
+	if *actual.Severity != SeverityHigh {
+		t.Errorf("expected %s, got %s", SeverityHigh, *actual.Severity)
+	}
+
+	var profile UserProfile
+	if err := json.Unmarshal([]byte(`{"global_role": {"role": 2}}`), &profile); err != nil {
+		t.Fatalf("error: %s", err)
+	}
+	if *profile.GlobalRole.Role != RoleWriter {
+		t.Errorf("expected %s, got %s", RoleWriter, *profile.GlobalRole.Role)
+	}
+}
+
+func TestRoleID_String(t *testing.T) {
+	if RoleAPIImporter.String() != "API Importer" {
+		t.Errorf("expected API Importer, got %s", RoleAPIImporter)
+	}
+	if RoleID(42).String() != "RoleID(42)" {
+		t.Errorf("expected RoleID(42), got %s", RoleID(42))
+	}
+}
+
+func TestEnums_invalidValuesAreNotSent(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("no request should have been sent")
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	_, err := dj.Findings.PartialUpdate(context.Background(), 1, &Finding{Severity: Ptr(Severity("critical"))})
+	if cmp.Equal(err, nil) {
+		t.Errorf("expected an error for an invalid severity")
+	}
+
+	_, err = dj.ImportScan.Create(context.Background(), &ImportScan{
+		ScanType:        Str("Trivy Scan"),
+		MinimumSeverity: Ptr(Severity("critical")),
+	})
+	if cmp.Equal(err, nil) {
+		t.Errorf("expected an error for an invalid minimum severity")
+	}
+}
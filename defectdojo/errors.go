@@ -0,0 +1,124 @@
+package defectdojo
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// APIError is returned when the DefectDojo API answers with a non-2xx status code.
+// It can be retrieved from any error returned by the client with errors.As.
+type APIError struct {
+	// StatusCode is the HTTP status code of the response
+	StatusCode int
+	// Method is the HTTP method of the failed request
+	Method string
+	// URL is the URL of the failed request
+	URL string
+	// Body is the raw body of the response
+	Body []byte
+	// Detail is the "detail" message DefectDojo sends for authentication, permission and lookup failures
+	Detail string
+	// Message is the "message" field some DefectDojo endpoints use instead of Detail
+	Message string
+	// FieldErrors maps request field names to the validation messages reported for them
+	FieldErrors map[string][]string
+}
+
+func (e *APIError) Error() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "API error: %s %s: %d %s", e.Method, e.URL, e.StatusCode, http.StatusText(e.StatusCode))
+
+	if len(e.Detail) > 0 {
+		fmt.Fprintf(&sb, ": %s", e.Detail)
+	}
+	if len(e.Message) > 0 {
+		fmt.Fprintf(&sb, ": %s", e.Message)
+	}
+
+	fields := make([]string, 0, len(e.FieldErrors))
+	for field := range e.FieldErrors {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	for _, field := range fields {
+		fmt.Fprintf(&sb, "; %s: %s", field, strings.Join(e.FieldErrors[field], " "))
+	}
+
+	return sb.String()
+}
+
+// newAPIError builds an APIError from a failed response, decoding the error payload
+// if it is a JSON object.
+func newAPIError(res *http.Response, body []byte) *APIError {
+	e := &APIError{
+		StatusCode: res.StatusCode,
+		Body:       body,
+	}
+	if res.Request != nil {
+		e.Method = res.Request.Method
+		if res.Request.URL != nil {
+			e.URL = res.Request.URL.String()
+		}
+	}
+
+	var payload map[string]json.RawMessage
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return e
+	}
+
+	for key, raw := range payload {
+		var str string
+		var list []string
+		switch {
+		case key == "detail" && json.Unmarshal(raw, &str) == nil:
+			e.Detail = str
+		case key == "message" && json.Unmarshal(raw, &str) == nil:
+			e.Message = str
+		case json.Unmarshal(raw, &list) == nil:
+			e.addFieldError(key, list...)
+		case json.Unmarshal(raw, &str) == nil:
+			e.addFieldError(key, str)
+		default:
+			e.addFieldError(key, string(raw))
+		}
+	}
+
+	return e
+}
+
+func (e *APIError) addFieldError(field string, msgs ...string) {
+	if e.FieldErrors == nil {
+		e.FieldErrors = make(map[string][]string)
+	}
+	e.FieldErrors[field] = append(e.FieldErrors[field], msgs...)
+}
+
+// IsNotFound reports whether err is an APIError with status 404 Not Found.
+func IsNotFound(err error) bool {
+	return hasStatus(err, http.StatusNotFound)
+}
+
+// IsPermissionDenied reports whether err is an APIError with status 403 Forbidden.
+func IsPermissionDenied(err error) bool {
+	return hasStatus(err, http.StatusForbidden)
+}
+
+// IsUnauthorized reports whether err is an APIError with status 401 Unauthorized.
+func IsUnauthorized(err error) bool {
+	return hasStatus(err, http.StatusUnauthorized)
+}
+
+// IsBadRequest reports whether err is an APIError with status 400 Bad Request,
+// which DefectDojo uses for validation failures.
+func IsBadRequest(err error) bool {
+	return hasStatus(err, http.StatusBadRequest)
+}
+
+func hasStatus(err error, code int) bool {
+	var e *APIError
+	return errors.As(err, &e) && e.StatusCode == code
+}
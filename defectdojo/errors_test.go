@@ -0,0 +1,119 @@
+package defectdojo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestClient_sendRequest_APIError(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   int
+		body     string
+		expected APIError
+		check    func(error) bool
+	}{
+		{
+			name:   "not found",
+			status: http.StatusNotFound,
+			body:   `{"detail": "Not found."}`,
+			expected: APIError{
+				StatusCode: http.StatusNotFound,
+				Method:     http.MethodGet,
+				Detail:     "Not found.",
+			},
+			check: IsNotFound,
+		},
+		{
+			name:   "permission denied",
+			status: http.StatusForbidden,
+			body:   `{"detail": "You do not have permission to perform this action."}`,
+			expected: APIError{
+				StatusCode: http.StatusForbidden,
+				Method:     http.MethodGet,
+				Detail:     "You do not have permission to perform this action.",
+			},
+			check: IsPermissionDenied,
+		},
+		{
+			name:   "validation errors",
+			status: http.StatusBadRequest,
+			body:   `{"name": ["This field is required."], "non_field_errors": ["Invalid data."], "prod_type": "Invalid pk."}`,
+			expected: APIError{
+				StatusCode: http.StatusBadRequest,
+				Method:     http.MethodGet,
+				FieldErrors: map[string][]string{
+					"name":             {"This field is required."},
+					"non_field_errors": {"Invalid data."},
+					"prod_type":        {"Invalid pk."},
+				},
+			},
+			check: IsBadRequest,
+		},
+		{
+			name:   "non JSON body",
+			status: http.StatusBadGateway,
+			body:   `<html>Bad Gateway</html>`,
+			expected: APIError{
+				StatusCode: http.StatusBadGateway,
+				Method:     http.MethodGet,
+			},
+			check: func(err error) bool { return !IsNotFound(err) },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				_, _ = fmt.Fprint(w, tt.body)
+			}))
+			defer ts.Close()
+
+			dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+			_, err := dj.Products.Read(context.Background(), 1)
+			if cmp.Equal(err, nil) {
+				t.Fatalf("expected an error")
+			}
+
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("expected an *APIError, got %T", err)
+			}
+
+			tt.expected.URL = ts.URL + "/api/v2/products/1/"
+			tt.expected.Body = []byte(tt.body)
+			if !cmp.Equal(*apiErr, tt.expected) {
+				t.Errorf("should have been equal, %+v, %+v", *apiErr, tt.expected)
+			}
+
+			if !tt.check(err) {
+				t.Errorf("status check failed for %v", err)
+			}
+		})
+	}
+}
+
+func TestAPIError_Error(t *testing.T) {
+	err := &APIError{
+		StatusCode: http.StatusBadRequest,
+		Method:     http.MethodPost,
+		URL:        "https://dojo/api/v2/products/",
+		FieldErrors: map[string][]string{
+			"prod_type": {"This field is required."},
+			"name":      {"This field may not be blank."},
+		},
+	}
+
+	expected := "API error: POST https://dojo/api/v2/products/: 400 Bad Request; name: This field may not be blank.; prod_type: This field is required."
+	if err.Error() != expected {
+		t.Errorf("expected %s, got %s", expected, err.Error())
+	}
+}
@@ -0,0 +1,239 @@
+package defectdojo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// Values of ImportScan.GroupBy and ReImportScan.GroupBy, selecting how imported
+// findings are collected into finding groups.
+const (
+	FindingGroupByComponentName        = "component_name"
+	FindingGroupByComponentNameVersion = "component_name+component_version"
+	FindingGroupByFilePath             = "file_path"
+	FindingGroupByFindingTitle         = "finding_title"
+)
+
+type FindingGroupsService struct {
+	client *Client
+}
+
+type FindingGroup struct {
+	Id        *int       `json:"id,omitempty"`
+	Name      *string    `json:"name,omitempty"`
+	Test      *int       `json:"test,omitempty"`
+	Findings  *[]int     `json:"findings,omitempty"`
+	CreatedBy *int       `json:"created_by,omitempty"`
+	Created   *time.Time `json:"created,omitempty"`
+	Modified  *time.Time `json:"modified,omitempty"`
+	JiraIssue *JiraIssue `json:"jira_issue,omitempty"`
+}
+
+// JiraIssue links a finding, finding group or engagement to an issue in Jira.
+type JiraIssue struct {
+	Id           *int       `json:"id,omitempty"`
+	Url          *string    `json:"url,omitempty"`
+	JiraId       *string    `json:"jira_id,omitempty"`
+	JiraKey      *string    `json:"jira_key,omitempty"`
+	JiraCreation *time.Time `json:"jira_creation,omitempty"`
+	JiraChange   *time.Time `json:"jira_change,omitempty"`
+	JiraProject  *int       `json:"jira_project,omitempty"`
+	Finding      *int       `json:"finding,omitempty"`
+	Engagement   *int       `json:"engagement,omitempty"`
+	FindingGroup *int       `json:"finding_group,omitempty"`
+}
+
+type FindingGroups struct {
+	Count    *int            `json:"count,omitempty"`
+	Next     *string         `json:"next,omitempty"`
+	Previous *string         `json:"previous,omitempty"`
+	Results  *[]FindingGroup `json:"results,omitempty"`
+}
+
+type FindingGroupsOptions struct {
+	Limit   int
+	Offset  int
+	ID      int
+	Name    string
+	Test    int
+	Finding int
+}
+
+func (o *FindingGroupsOptions) ToString() string {
+	if o == nil {
+		return ""
+	}
+
+	v := url.Values{}
+	if o.Limit > 0 {
+		v.Set("limit", strconv.Itoa(o.Limit))
+	}
+	if o.Offset > 0 {
+		v.Set("offset", strconv.Itoa(o.Offset))
+	}
+	if o.ID > 0 {
+		v.Set("id", strconv.Itoa(o.ID))
+	}
+	if len(o.Name) > 0 {
+		v.Set("name", o.Name)
+	}
+	if o.Test > 0 {
+		v.Set("test", strconv.Itoa(o.Test))
+	}
+	if o.Finding > 0 {
+		v.Set("findings", strconv.Itoa(o.Finding))
+	}
+
+	return "?" + v.Encode()
+}
+
+func (c *FindingGroupsService) List(ctx context.Context, options *FindingGroupsOptions) (*FindingGroups, error) {
+	path := fmt.Sprintf("%s/finding_groups/%s", c.client.BaseURL, options.ToString())
+
+	req, err := http.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := FindingGroups{}
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// All returns an iterator over all finding groups matching the given options,
+// fetching further pages from DefectDojo as the iteration advances.
+func (c *FindingGroupsService) All(ctx context.Context, options *FindingGroupsOptions) iter.Seq2[FindingGroup, error] {
+	path := fmt.Sprintf("%s/finding_groups/%s", c.client.BaseURL, options.ToString())
+
+	return all[FindingGroup](ctx, c.client, path)
+}
+
+func (c *FindingGroupsService) Read(ctx context.Context, id int) (*FindingGroup, error) {
+	path := fmt.Sprintf("%s/finding_groups/%d/", c.client.BaseURL, id)
+
+	req, err := http.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(FindingGroup)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *FindingGroupsService) Create(ctx context.Context, u *FindingGroup) (*FindingGroup, error) {
+	path := fmt.Sprintf("%s/finding_groups/", c.client.BaseURL)
+
+	postJSON, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPost, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(FindingGroup)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *FindingGroupsService) PartialUpdate(ctx context.Context, id int, u *FindingGroup) (*FindingGroup, error) {
+	path := fmt.Sprintf("%s/finding_groups/%d/", c.client.BaseURL, id)
+
+	postJSON, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPatch, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(FindingGroup)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *FindingGroupsService) Delete(ctx context.Context, id int) (*FindingGroup, error) {
+	path := fmt.Sprintf("%s/finding_groups/%d/", c.client.BaseURL, id)
+
+	req, err := http.NewRequest(http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(FindingGroup)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// AddFindings adds findings to the group, keeping its existing findings.
+// The findings of the group are read and written back with a partial update.
+func (c *FindingGroupsService) AddFindings(ctx context.Context, id int, findings []int) (*FindingGroup, error) {
+	fg, err := c.Read(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	merged := mergeUnique(fg.Findings, findings)
+	return c.PartialUpdate(ctx, id, &FindingGroup{Findings: &merged})
+}
+
+// RemoveFindings removes findings from the group.
+// Like AddFindings, it reads the findings of the group and writes the remaining ones back.
+func (c *FindingGroupsService) RemoveFindings(ctx context.Context, id int, findings []int) (*FindingGroup, error) {
+	fg, err := c.Read(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	remaining := without(fg.Findings, findings)
+	return c.PartialUpdate(ctx, id, &FindingGroup{Findings: &remaining})
+}
+
+// PushToJira creates or updates the Jira issue of the group.
+func (c *FindingGroupsService) PushToJira(ctx context.Context, id int) error {
+	path := fmt.Sprintf("%s/finding_groups/%d/push_to_jira/", c.client.BaseURL, id)
+
+	req, err := http.NewRequest(http.MethodPost, path, nil)
+	if err != nil {
+		return err
+	}
+
+	req = req.WithContext(ctx)
+
+	return c.client.sendRequest(req, nil)
+}
@@ -0,0 +1,281 @@
+package defectdojo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestFindingGroupsService_List(t *testing.T) {
+	response := `{
+		"count": 1,
+		"next": null,
+		"previous": null,
+		"results": [
+			{
+				"id": 9,
+				"name": "log4j-core 2.14.1",
+				"test": 12,
+				"findings": [101, 102],
+				"jira_issue": {"id": 3, "jira_key": "SEC-42", "finding_group": 9}
+			}
+		]
+	}`
+
+	expected := FindingGroups{
+		Count:    Int(1),
+		Next:     nil,
+		Previous: nil,
+		Results: &[]FindingGroup{
+			{
+				Id:       Int(9),
+				Name:     Str("log4j-core 2.14.1"),
+				Test:     Int(12),
+				Findings: &[]int{101, 102},
+				JiraIssue: &JiraIssue{
+					Id:           Int(3),
+					JiraKey:      Str("SEC-42"),
+					FindingGroup: Int(9),
+				},
+			},
+		},
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected GET request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/finding_groups/") {
+			t.Errorf("Expected /finding_groups/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.FindingGroups.List(context.Background(), &FindingGroupsOptions{
+		Limit: 10,
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestFindingGroupsService_Read(t *testing.T) {
+	response := `{
+		"id": 9,
+		"name": "log4j-core 2.14.1",
+		"test": 12,
+		"findings": [101, 102],
+		"jira_issue": {"id": 3, "jira_key": "SEC-42", "finding_group": 9}
+	}`
+
+	expected := FindingGroup{
+		Id:       Int(9),
+		Name:     Str("log4j-core 2.14.1"),
+		Test:     Int(12),
+		Findings: &[]int{101, 102},
+		JiraIssue: &JiraIssue{
+			Id:           Int(3),
+			JiraKey:      Str("SEC-42"),
+			FindingGroup: Int(9),
+		},
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected GET request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/finding_groups/9/") {
+			t.Errorf("Expected /finding_groups/9/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.FindingGroups.Read(context.Background(), 9)
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestFindingGroupsService_Create(t *testing.T) {
+	response := `{
+		"id": 9,
+		"name": "log4j-core 2.14.1",
+		"test": 12,
+		"findings": [101, 102],
+		"jira_issue": {"id": 3, "jira_key": "SEC-42", "finding_group": 9}
+	}`
+
+	expected := FindingGroup{
+		Id:       Int(9),
+		Name:     Str("log4j-core 2.14.1"),
+		Test:     Int(12),
+		Findings: &[]int{101, 102},
+		JiraIssue: &JiraIssue{
+			Id:           Int(3),
+			JiraKey:      Str("SEC-42"),
+			FindingGroup: Int(9),
+		},
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("Expected POST request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/finding_groups/") {
+			t.Errorf("Expected /finding_groups/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.FindingGroups.Create(context.Background(), &FindingGroup{
+		Name:     Str("log4j-core 2.14.1"),
+		Test:     Int(12),
+		Findings: &[]int{101, 102},
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestFindingGroupsService_Delete(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("Expected DELETE request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/finding_groups/9/") {
+			t.Errorf("Expected /finding_groups/9/ in path, got %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	_, err := dj.FindingGroups.Delete(context.Background(), 9)
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+}
+
+func TestFindingGroupsOptions_ToString(t *testing.T) {
+	tests := []struct {
+		name     string
+		options  *FindingGroupsOptions
+		expected string
+	}{
+		{
+			name: "test only",
+			options: &FindingGroupsOptions{
+				Test: 12,
+			},
+			expected: "?test=12",
+		},
+		{
+			name: "all fields",
+			options: &FindingGroupsOptions{
+				Limit:   10,
+				Offset:  20,
+				Name:    "log4j-core 2.14.1",
+				Test:    12,
+				Finding: 101,
+			},
+			expected: "?findings=101&limit=10&name=log4j-core+2.14.1&offset=20&test=12",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := tt.options.ToString()
+			if actual != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestFindingGroupsService_Findings(t *testing.T) {
+	findings := []int{101, 102}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.Contains(r.URL.Path, "/finding_groups/9/") {
+			t.Errorf("Expected /finding_groups/9/ in path, got %s", r.URL.Path)
+		}
+		switch r.Method {
+		case http.MethodGet:
+		case http.MethodPatch:
+			var body FindingGroup
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("cannot decode body: %s", err)
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			findings = *body.Findings
+		default:
+			t.Errorf("unexpected %s request", r.Method)
+		}
+		_ = json.NewEncoder(w).Encode(&FindingGroup{Id: Int(9), Findings: &findings})
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.FindingGroups.AddFindings(context.Background(), 9, []int{103})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+	if !cmp.Equal(*actual.Findings, []int{101, 102, 103}) {
+		t.Errorf("unexpected findings %v", *actual.Findings)
+	}
+
+	actual, err = dj.FindingGroups.RemoveFindings(context.Background(), 9, []int{101, 102})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+	if !cmp.Equal(*actual.Findings, []int{103}) {
+		t.Errorf("unexpected findings %v", *actual.Findings)
+	}
+}
+
+func TestFindingGroupsService_PushToJira(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("Expected POST request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/finding_groups/9/push_to_jira/") {
+			t.Errorf("Expected /finding_groups/9/push_to_jira/ in path, got %s", r.URL.Path)
+		}
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	if err := dj.FindingGroups.PushToJira(context.Background(), 9); !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+}
@@ -1,9 +1,15 @@
 package defectdojo
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
+	"iter"
 	"net/http"
+	"net/url"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -18,29 +24,10 @@ type Finding struct {
 	RequestResponse *struct {
 		ReqResp *[]map[string]string `json:"req_resp,omitempty"`
 	} `json:"request_response,omitempty"`
-	AcceptedRisks *[]struct {
-		Id                    *int       `json:"id,omitempty"`
-		Name                  *string    `json:"name,omitempty"`
-		Recommendation        *string    `json:"recommendation,omitempty"`
-		RecommendationDetails *string    `json:"recommendation_details,omitempty"`
-		Decision              *string    `json:"decision,omitempty"`
-		DecisionDetails       *string    `json:"decision_details,omitempty"`
-		AcceptedBy            *string    `json:"accepted_by,omitempty"`
-		Path                  *string    `json:"path,omitempty"`
-		ExpirationDate        *time.Time `json:"expiration_date,omitempty"`
-		ExpirationDateWarned  *time.Time `json:"expiration_date_warned,omitempty"`
-		ExpirationDateHandled *time.Time `json:"expiration_date_handled,omitempty"`
-		ReactivateExpired     *bool      `json:"reactivate_expired,omitempty"`
-		RestartSlaExpired     *bool      `json:"restart_sla_expired,omitempty"`
-		Created               *time.Time `json:"created,omitempty"`
-		Updated               *time.Time `json:"updated,omitempty"`
-		Owner                 *int       `json:"owner,omitempty"`
-		AcceptedFindings      *[]int     `json:"accepted_findings,omitempty"`
-		Notes                 *[]int     `json:"notes,omitempty"`
-	} `json:"accepted_risks,omitempty"`
-	PushToJira       *bool `json:"push_to_jira,omitempty"`
-	Age              *int  `json:"age,omitempty"`
-	SlaDaysRemaining *int  `json:"sla_days_remaining,omitempty"`
+	AcceptedRisks    *[]RiskAcceptance `json:"accepted_risks,omitempty"`
+	PushToJira       *bool             `json:"push_to_jira,omitempty"`
+	Age              *int              `json:"age,omitempty"`
+	SlaDaysRemaining *int              `json:"sla_days_remaining,omitempty"`
 	FindingMeta      *[]struct {
 		Name  *string `json:"name,omitempty"`
 		Value *string `json:"value,omitempty"`
@@ -78,102 +65,75 @@ type Finding struct {
 			CommitHash *string `json:"commit_hash,omitempty"`
 			Version    *string `json:"version,omitempty"`
 		} `json:"test,omitempty"`
-		Jira *struct {
-			Id           *int       `json:"id,omitempty"`
-			Url          *string    `json:"url,omitempty"`
-			JiraId       *string    `json:"jira_id,omitempty"`
-			JiraKey      *string    `json:"jira_key,omitempty"`
-			JiraCreation *time.Time `json:"jira_creation,omitempty"`
-			JiraChange   *time.Time `json:"jira_change,omitempty"`
-			JiraProject  *int       `json:"jira_project,omitempty"`
-			Finding      *int       `json:"finding,omitempty"`
-			Engagement   *int       `json:"engagement,omitempty"`
-			FindingGroup *int       `json:"finding_group,omitempty"`
-		} `json:"jira,omitempty"`
+		Jira *JiraIssue `json:"jira,omitempty"`
 	} `json:"related_fields,omitempty"`
-	JiraCreation  *time.Time `json:"jira_creation,omitempty"`
-	JiraChange    *time.Time `json:"jira_change,omitempty"`
-	DisplayStatus *string    `json:"display_status,omitempty"`
-	FindingGroups *[]struct {
-		Id        *int    `json:"id,omitempty"`
-		Name      *string `json:"name,omitempty"`
-		Test      *int    `json:"test,omitempty"`
-		JiraIssue *struct {
-			Id           *int       `json:"id,omitempty"`
-			Url          *string    `json:"url,omitempty"`
-			JiraId       *string    `json:"jira_id,omitempty"`
-			JiraKey      *string    `json:"jira_key,omitempty"`
-			JiraCreation *time.Time `json:"jira_creation,omitempty"`
-			JiraChange   *time.Time `json:"jira_change,omitempty"`
-			JiraProject  *int       `json:"jira_project,omitempty"`
-			Finding      *int       `json:"finding,omitempty"`
-			Engagement   *int       `json:"engagement,omitempty"`
-			FindingGroup *int       `json:"finding_group,omitempty"`
-		} `json:"jira_issue,omitempty"`
-	} `json:"finding_groups,omitempty"`
-	Title                   *string    `json:"title,omitempty"`
-	Date                    *string    `json:"date,omitempty"`
-	SlaStartDate            *string    `json:"sla_start_date,omitempty"`
-	Cwe                     *int       `json:"cwe,omitempty"`
-	Cve                     *string    `json:"cve,omitempty"`
-	Cvssv3                  *string    `json:"cvssv3,omitempty"`
-	Cvssv3Score             *float32   `json:"cvssv3_score,omitempty"`
-	Url                     *string    `json:"url,omitempty"`
-	Severity                *string    `json:"severity,omitempty"`
-	Description             *string    `json:"description,omitempty"`
-	Mitigation              *string    `json:"mitigation,omitempty"`
-	Impact                  *string    `json:"impact,omitempty"`
-	StepsToReproduce        *string    `json:"steps_to_reproduce,omitempty"`
-	SeverityJustification   *string    `json:"severity_justification,omitempty"`
-	References              *string    `json:"references,omitempty"`
-	Active                  *bool      `json:"active,omitempty"`
-	Verified                *bool      `json:"verified,omitempty"`
-	FalseP                  *bool      `json:"false_p,omitempty"`
-	Duplicate               *bool      `json:"duplicate,omitempty"`
-	OutOfScope              *bool      `json:"out_of_scope,omitempty"`
-	RiskAccepted            *bool      `json:"risk_accepted,omitempty"`
-	UnderReview             *bool      `json:"under_review,omitempty"`
-	LastStatusUpdate        *time.Time `json:"last_status_update,omitempty"`
-	UnderDefectReview       *bool      `json:"under_defect_review,omitempty"`
-	IsMitigated             *bool      `json:"is_mitigated,omitempty"`
-	ThreadId                *int       `json:"thread_id,omitempty"`
-	Mitigated               *time.Time `json:"mitigated,omitempty"`
-	NumericalSeverity       *string    `json:"numerical_severity,omitempty"`
-	LastReviewed            *time.Time `json:"last_reviewed,omitempty"`
-	Param                   *string    `json:"param,omitempty"`
-	Payload                 *string    `json:"payload,omitempty"`
-	HashCode                *string    `json:"hash_code,omitempty"`
-	Line                    *int       `json:"line,omitempty"`
-	FilePath                *string    `json:"file_path,omitempty"`
-	ComponentName           *string    `json:"component_name,omitempty"`
-	ComponentVersion        *string    `json:"component_version,omitempty"`
-	StaticFinding           *bool      `json:"static_finding,omitempty"`
-	DynamicFinding          *bool      `json:"dynamic_finding,omitempty"`
-	Created                 *time.Time `json:"created,omitempty"`
-	ScannerConfidence       *int       `json:"scanner_confidence,omitempty"`
-	UniqueIdFromTool        *string    `json:"unique_id_from_tool,omitempty"`
-	VulnIdFromTool          *string    `json:"vuln_id_from_tool,omitempty"`
-	SastSourceObject        *string    `json:"sast_source_object,omitempty"`
-	SastSinkObject          *string    `json:"sast_sink_object,omitempty"`
-	SastSourceLine          *int       `json:"sast_source_line,omitempty"`
-	SastSourceFilePath      *string    `json:"sast_source_file_path,omitempty"`
-	NbOccurences            *int       `json:"nb_occurences,omitempty"`
-	PublishDate             *string    `json:"publish_date,omitempty"`
-	Service                 *string    `json:"service,omitempty"`
-	Test                    *int       `json:"test,omitempty"`
-	DuplicateFinding        *int       `json:"duplicate_finding,omitempty"`
-	ReviewRequestedBy       *int       `json:"review_requested_by,omitempty"`
-	DefectReviewRequestedBy *int       `json:"defect_review_requested_by,omitempty"`
-	MitigatedBy             *int       `json:"mitigated_by,omitempty"`
-	Reporter                *int       `json:"reporter,omitempty"`
-	LastReviewedBy          *int       `json:"last_reviewed_by,omitempty"`
-	SonarqubeIssue          *int       `json:"sonarqube_issue,omitempty"`
-	Endpoints               *[]int     `json:"endpoints,omitempty"`
-	EndpointStatus          *[]int     `json:"endpoint_status,omitempty"`
-	Reviewers               *[]int     `json:"reviewers,omitempty"`
-	Notes                   *[]Note    `json:"notes,omitempty"`
-	Files                   *[]int     `json:"files,omitempty"`
-	FoundBy                 *[]int     `json:"found_by,omitempty"`
+	JiraCreation            *time.Time      `json:"jira_creation,omitempty"`
+	JiraChange              *time.Time      `json:"jira_change,omitempty"`
+	DisplayStatus           *string         `json:"display_status,omitempty"`
+	FindingGroups           *[]FindingGroup `json:"finding_groups,omitempty"`
+	Title                   *string         `json:"title,omitempty"`
+	Date                    *string         `json:"date,omitempty"`
+	SlaStartDate            *string         `json:"sla_start_date,omitempty"`
+	Cwe                     *int            `json:"cwe,omitempty"`
+	Cve                     *string         `json:"cve,omitempty"`
+	Cvssv3                  *string         `json:"cvssv3,omitempty"`
+	Cvssv3Score             *float32        `json:"cvssv3_score,omitempty"`
+	Url                     *string         `json:"url,omitempty"`
+	Severity                *Severity       `json:"severity,omitempty"`
+	Description             *string         `json:"description,omitempty"`
+	Mitigation              *string         `json:"mitigation,omitempty"`
+	Impact                  *string         `json:"impact,omitempty"`
+	StepsToReproduce        *string         `json:"steps_to_reproduce,omitempty"`
+	SeverityJustification   *string         `json:"severity_justification,omitempty"`
+	References              *string         `json:"references,omitempty"`
+	Active                  *bool           `json:"active,omitempty"`
+	Verified                *bool           `json:"verified,omitempty"`
+	FalseP                  *bool           `json:"false_p,omitempty"`
+	Duplicate               *bool           `json:"duplicate,omitempty"`
+	OutOfScope              *bool           `json:"out_of_scope,omitempty"`
+	RiskAccepted            *bool           `json:"risk_accepted,omitempty"`
+	UnderReview             *bool           `json:"under_review,omitempty"`
+	LastStatusUpdate        *time.Time      `json:"last_status_update,omitempty"`
+	UnderDefectReview       *bool           `json:"under_defect_review,omitempty"`
+	IsMitigated             *bool           `json:"is_mitigated,omitempty"`
+	ThreadId                *int            `json:"thread_id,omitempty"`
+	Mitigated               *time.Time      `json:"mitigated,omitempty"`
+	NumericalSeverity       *string         `json:"numerical_severity,omitempty"`
+	LastReviewed            *time.Time      `json:"last_reviewed,omitempty"`
+	Param                   *string         `json:"param,omitempty"`
+	Payload                 *string         `json:"payload,omitempty"`
+	HashCode                *string         `json:"hash_code,omitempty"`
+	Line                    *int            `json:"line,omitempty"`
+	FilePath                *string         `json:"file_path,omitempty"`
+	ComponentName           *string         `json:"component_name,omitempty"`
+	ComponentVersion        *string         `json:"component_version,omitempty"`
+	StaticFinding           *bool           `json:"static_finding,omitempty"`
+	DynamicFinding          *bool           `json:"dynamic_finding,omitempty"`
+	Created                 *time.Time      `json:"created,omitempty"`
+	ScannerConfidence       *int            `json:"scanner_confidence,omitempty"`
+	UniqueIdFromTool        *string         `json:"unique_id_from_tool,omitempty"`
+	VulnIdFromTool          *string         `json:"vuln_id_from_tool,omitempty"`
+	SastSourceObject        *string         `json:"sast_source_object,omitempty"`
+	SastSinkObject          *string         `json:"sast_sink_object,omitempty"`
+	SastSourceLine          *int            `json:"sast_source_line,omitempty"`
+	SastSourceFilePath      *string         `json:"sast_source_file_path,omitempty"`
+	NbOccurences            *int            `json:"nb_occurences,omitempty"`
+	PublishDate             *string         `json:"publish_date,omitempty"`
+	Service                 *string         `json:"service,omitempty"`
+	Test                    *int            `json:"test,omitempty"`
+	DuplicateFinding        *int            `json:"duplicate_finding,omitempty"`
+	ReviewRequestedBy       *int            `json:"review_requested_by,omitempty"`
+	DefectReviewRequestedBy *int            `json:"defect_review_requested_by,omitempty"`
+	MitigatedBy             *int            `json:"mitigated_by,omitempty"`
+	Reporter                *int            `json:"reporter,omitempty"`
+	LastReviewedBy          *int            `json:"last_reviewed_by,omitempty"`
+	SonarqubeIssue          *int            `json:"sonarqube_issue,omitempty"`
+	Endpoints               *[]int          `json:"endpoints,omitempty"`
+	EndpointStatus          *[]int          `json:"endpoint_status,omitempty"`
+	Reviewers               *[]int          `json:"reviewers,omitempty"`
+	Notes                   *[]Note         `json:"notes,omitempty"`
+	Files                   *[]int          `json:"files,omitempty"`
+	FoundBy                 *[]int          `json:"found_by,omitempty"`
 }
 
 type Findings struct {
@@ -187,44 +147,91 @@ type Findings struct {
 }
 
 type FindingsOptions struct {
-	Limit    int
-	Offset   int
-	Title    string
-	Severity string
-	Active   string
-	Verified string
-	Prefetch string
+	Limit            int
+	Offset           int
+	ID               int
+	Title            string
+	Severity         Severity
+	Active           string
+	Verified         string
+	IsMitigated      string
+	FalseP           string
+	Duplicate        string
+	OutOfScope       string
+	RiskAccepted     string
+	Test             int
+	Engagement       int
+	Product          int
+	ProductType      int
+	Cwe              int
+	Cve              string
+	VulnerabilityID  string
+	Tags             []string
+	ComponentName    string
+	ComponentVersion string
+	FilePath         string
+	DiscoveredOn     string
+	DiscoveredBefore string
+	DiscoveredAfter  string
+	MitigatedBefore  string
+	MitigatedAfter   string
+	Ordering         string
+	RelatedFields    bool
+	Prefetch         string
 }
 
 func (o *FindingsOptions) ToString() string {
-	var opts []string
-	var optsString string
-	if o != nil {
-		optsString += "?"
-		if o.Limit > 0 {
-			opts = append(opts, fmt.Sprintf("limit=%d", o.Limit))
-		}
-		if o.Offset > 0 {
-			opts = append(opts, fmt.Sprintf("offset=%d", o.Offset))
-		}
-		if len(o.Title) > 0 {
-			opts = append(opts, fmt.Sprintf("title=%s", o.Title))
-		}
-		if len(o.Severity) > 0 {
-			opts = append(opts, fmt.Sprintf("severity=%s", o.Severity))
-		}
-		if len(o.Active) > 0 {
-			opts = append(opts, fmt.Sprintf("active=%s", o.Active))
-		}
-		if len(o.Verified) > 0 {
-			opts = append(opts, fmt.Sprintf("verified=%s", o.Verified))
+	if o == nil {
+		return ""
+	}
+
+	v := url.Values{}
+	setInt := func(key string, val int) {
+		if val > 0 {
+			v.Set(key, strconv.Itoa(val))
 		}
-		if len(o.Prefetch) > 0 {
-			opts = append(opts, fmt.Sprintf("prefetch=%s", o.Prefetch))
+	}
+	setStr := func(key string, val string) {
+		if len(val) > 0 {
+			v.Set(key, val)
 		}
-		optsString += strings.Join(opts, "&")
 	}
-	return optsString
+
+	setInt("limit", o.Limit)
+	setInt("offset", o.Offset)
+	setInt("id", o.ID)
+	setStr("title", o.Title)
+	setStr("severity", string(o.Severity))
+	setStr("active", o.Active)
+	setStr("verified", o.Verified)
+	setStr("is_mitigated", o.IsMitigated)
+	setStr("false_p", o.FalseP)
+	setStr("duplicate", o.Duplicate)
+	setStr("out_of_scope", o.OutOfScope)
+	setStr("risk_accepted", o.RiskAccepted)
+	setInt("test", o.Test)
+	setInt("test__engagement", o.Engagement)
+	setInt("test__engagement__product", o.Product)
+	setInt("test__engagement__product__prod_type", o.ProductType)
+	setInt("cwe", o.Cwe)
+	setStr("cve", o.Cve)
+	setStr("vulnerability_id", o.VulnerabilityID)
+	setStr("tags", strings.Join(o.Tags, ","))
+	setStr("component_name", o.ComponentName)
+	setStr("component_version", o.ComponentVersion)
+	setStr("file_path", o.FilePath)
+	setStr("discovered_on", o.DiscoveredOn)
+	setStr("discovered_before", o.DiscoveredBefore)
+	setStr("discovered_after", o.DiscoveredAfter)
+	setStr("mitigated_before", o.MitigatedBefore)
+	setStr("mitigated_after", o.MitigatedAfter)
+	setStr("o", o.Ordering)
+	if o.RelatedFields {
+		v.Set("related_fields", "true")
+	}
+	setStr("prefetch", o.Prefetch)
+
+	return "?" + v.Encode()
 }
 
 func (c *FindingsService) List(ctx context.Context, options *FindingsOptions) (*Findings, error) {
@@ -245,6 +252,14 @@ func (c *FindingsService) List(ctx context.Context, options *FindingsOptions) (*
 	return &res, nil
 }
 
+// All returns an iterator over all findings matching the given options,
+// fetching further pages from DefectDojo as the iteration advances.
+func (c *FindingsService) All(ctx context.Context, options *FindingsOptions) iter.Seq2[Finding, error] {
+	path := fmt.Sprintf("%s/findings/%s", c.client.BaseURL, options.ToString())
+
+	return all[Finding](ctx, c.client, path)
+}
+
 func (c *FindingsService) Read(ctx context.Context, id int) (*Finding, error) {
 	path := fmt.Sprintf("%s/findings/%d/", c.client.BaseURL, id)
 
@@ -262,3 +277,417 @@ func (c *FindingsService) Read(ctx context.Context, id int) (*Finding, error) {
 
 	return res, nil
 }
+
+func (c *FindingsService) Create(ctx context.Context, u *Finding) (*Finding, error) {
+	path := fmt.Sprintf("%s/findings/", c.client.BaseURL)
+
+	postJSON, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPost, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(Finding)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *FindingsService) Update(ctx context.Context, id int, u *Finding) (*Finding, error) {
+	path := fmt.Sprintf("%s/findings/%d/", c.client.BaseURL, id)
+
+	postJSON, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPut, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(Finding)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *FindingsService) PartialUpdate(ctx context.Context, id int, u *Finding) (*Finding, error) {
+	path := fmt.Sprintf("%s/findings/%d/", c.client.BaseURL, id)
+
+	postJSON, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPatch, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(Finding)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *FindingsService) Delete(ctx context.Context, id int) (*Finding, error) {
+	path := fmt.Sprintf("%s/findings/%d/", c.client.BaseURL, id)
+
+	req, err := http.NewRequest(http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(Finding)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// FindingClose holds the parameters of the close action of a finding.
+type FindingClose struct {
+	IsMitigated *bool      `json:"is_mitigated,omitempty"`
+	Mitigated   *time.Time `json:"mitigated,omitempty"`
+	FalseP      *bool      `json:"false_p,omitempty"`
+	OutOfScope  *bool      `json:"out_of_scope,omitempty"`
+	Duplicate   *bool      `json:"duplicate,omitempty"`
+	Note        *string    `json:"note,omitempty"`
+	NoteType    *int       `json:"note_type,omitempty"`
+}
+
+// Close mitigates the finding through the close action, recording note as the reason,
+// and returns the updated finding.
+func (c *FindingsService) Close(ctx context.Context, id int, note string) (*Finding, error) {
+	path := fmt.Sprintf("%s/findings/%d/close/", c.client.BaseURL, id)
+
+	u := &FindingClose{
+		IsMitigated: Bool(true),
+		Mitigated:   Date(time.Now().UTC()),
+		FalseP:      Bool(false),
+		OutOfScope:  Bool(false),
+		Duplicate:   Bool(false),
+	}
+	if len(note) > 0 {
+		u.Note = Str(note)
+	}
+
+	postJSON, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPost, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(FindingClose)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return c.Read(ctx, id)
+}
+
+// MarkFalsePositive deactivates the finding and flags it as a false positive.
+func (c *FindingsService) MarkFalsePositive(ctx context.Context, id int) (*Finding, error) {
+	return c.PartialUpdate(ctx, id, &Finding{
+		Active:   Bool(false),
+		Verified: Bool(false),
+		FalseP:   Bool(true),
+	})
+}
+
+// MarkOutOfScope deactivates the finding and flags it as out of scope.
+func (c *FindingsService) MarkOutOfScope(ctx context.Context, id int) (*Finding, error) {
+	return c.PartialUpdate(ctx, id, &Finding{
+		Active:     Bool(false),
+		Verified:   Bool(false),
+		OutOfScope: Bool(true),
+	})
+}
+
+// Reopen reactivates the finding, clearing its mitigated, false positive and out of scope flags.
+func (c *FindingsService) Reopen(ctx context.Context, id int) (*Finding, error) {
+	return c.PartialUpdate(ctx, id, &Finding{
+		Active:      Bool(true),
+		IsMitigated: Bool(false),
+		FalseP:      Bool(false),
+		OutOfScope:  Bool(false),
+	})
+}
+
+// Verify marks the finding as verified. Its other statuses are left untouched,
+// so a closed or false positive finding is not reactivated.
+func (c *FindingsService) Verify(ctx context.Context, id int) (*Finding, error) {
+	return c.PartialUpdate(ctx, id, &Finding{
+		Verified: Bool(true),
+	})
+}
+
+// FindingTags holds the tags of a finding.
+type FindingTags struct {
+	Tags *[]string `json:"tags,omitempty"`
+}
+
+// File is a file attached to a finding, test or engagement.
+type File struct {
+	Id    *int    `json:"id,omitempty"`
+	File  *string `json:"file,omitempty"`
+	Title *string `json:"title,omitempty"`
+}
+
+// Files holds the files attached to a finding, test or engagement.
+type Files struct {
+	Files *[]File `json:"files,omitempty"`
+}
+
+// RequestResponse is an HTTP request and response pair kept as evidence of a finding.
+type RequestResponse struct {
+	Request  *string `json:"request,omitempty"`
+	Response *string `json:"response,omitempty"`
+}
+
+// FindingRequestResponses holds the HTTP request and response pairs of a finding.
+type FindingRequestResponses struct {
+	ReqResp *[]RequestResponse `json:"req_resp,omitempty"`
+}
+
+// FindingNotes holds the notes of a finding.
+type FindingNotes struct {
+	FindingId *int    `json:"finding_id,omitempty"`
+	Notes     *[]Note `json:"notes,omitempty"`
+}
+
+// ListNotes returns the notes of the finding.
+func (c *FindingsService) ListNotes(ctx context.Context, id int) (*FindingNotes, error) {
+	path := fmt.Sprintf("%s/findings/%d/notes/", c.client.BaseURL, id)
+
+	req, err := http.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(FindingNotes)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// AddNote adds a note to the finding.
+func (c *FindingsService) AddNote(ctx context.Context, id int, u *NewNote) (*Note, error) {
+	path := fmt.Sprintf("%s/findings/%d/notes/", c.client.BaseURL, id)
+
+	postJSON, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPost, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(Note)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// RemoveNote removes the note with ID noteID from the finding and deletes it.
+func (c *FindingsService) RemoveNote(ctx context.Context, id int, noteID int) error {
+	path := fmt.Sprintf("%s/findings/%d/remove_note/", c.client.BaseURL, id)
+
+	postJSON, err := json.Marshal(map[string]int{"note_id": noteID})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodPatch, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return err
+	}
+
+	req = req.WithContext(ctx)
+
+	return c.client.sendRequest(req, nil)
+}
+
+// ListTags returns the tags of the finding.
+func (c *FindingsService) ListTags(ctx context.Context, id int) (*FindingTags, error) {
+	path := fmt.Sprintf("%s/findings/%d/tags/", c.client.BaseURL, id)
+
+	req, err := http.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(FindingTags)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// AddTags adds tags to the finding in a single request, keeping its existing tags.
+func (c *FindingsService) AddTags(ctx context.Context, id int, tags []string) (*FindingTags, error) {
+	path := fmt.Sprintf("%s/findings/%d/tags/", c.client.BaseURL, id)
+
+	postJSON, err := json.Marshal(&FindingTags{Tags: &tags})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPost, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(FindingTags)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// RemoveTags removes tags from the finding in a single request.
+func (c *FindingsService) RemoveTags(ctx context.Context, id int, tags []string) error {
+	path := fmt.Sprintf("%s/findings/%d/remove_tags/", c.client.BaseURL, id)
+
+	postJSON, err := json.Marshal(&FindingTags{Tags: &tags})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodPut, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return err
+	}
+
+	req = req.WithContext(ctx)
+
+	return c.client.sendRequest(req, nil)
+}
+
+// ListFiles returns the files attached to the finding.
+func (c *FindingsService) ListFiles(ctx context.Context, id int) (*Files, error) {
+	path := fmt.Sprintf("%s/findings/%d/files/", c.client.BaseURL, id)
+
+	req, err := http.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(Files)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// AddFile uploads a file with the given title and attaches it to the finding.
+func (c *FindingsService) AddFile(ctx context.Context, id int, title string, upload *FileUpload) (*File, error) {
+	path := fmt.Sprintf("%s/findings/%d/files/", c.client.BaseURL, id)
+
+	req, err := newFileUploadRequest(path, &importScanMap{"title": title}, upload)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(File)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// DownloadFile writes the contents of the file with ID fileID attached to the finding to w.
+func (c *FindingsService) DownloadFile(ctx context.Context, id int, fileID int, w io.Writer) error {
+	path := fmt.Sprintf("%s/findings/%d/files/download/%d/", c.client.BaseURL, id, fileID)
+
+	req, err := http.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return err
+	}
+
+	req = req.WithContext(ctx)
+
+	return c.client.sendRequest(req, w)
+}
+
+// ListRequestResponses returns the HTTP request and response pairs attached to the finding.
+func (c *FindingsService) ListRequestResponses(ctx context.Context, id int) (*FindingRequestResponses, error) {
+	path := fmt.Sprintf("%s/findings/%d/request_response/", c.client.BaseURL, id)
+
+	req, err := http.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(FindingRequestResponses)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// AddRequestResponses attaches HTTP request and response pairs to the finding as evidence.
+func (c *FindingsService) AddRequestResponses(ctx context.Context, id int, pairs []RequestResponse) (*FindingRequestResponses, error) {
+	path := fmt.Sprintf("%s/findings/%d/request_response/", c.client.BaseURL, id)
+
+	postJSON, err := json.Marshal(&FindingRequestResponses{ReqResp: &pairs})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPost, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(FindingRequestResponses)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
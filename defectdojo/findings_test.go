@@ -2,7 +2,9 @@ package defectdojo
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -46,7 +48,7 @@ func TestFindingsService_List(t *testing.T) {
 				Title:       Str("SQL Injection in Login"),
 				Date:        Str("2022-01-15"),
 				Cwe:         Int(89),
-				Severity:    Str("Critical"),
+				Severity:    Ptr(SeverityCritical),
 				Description: Str("SQL injection vulnerability found"),
 				Active:      Bool(true),
 				Verified:    Bool(true),
@@ -107,7 +109,7 @@ func TestFindingsService_Read(t *testing.T) {
 		Title:       Str("Reflected XSS"),
 		Date:        Str("2022-02-01"),
 		Cwe:         Int(79),
-		Severity:    Str("High"),
+		Severity:    Ptr(SeverityHigh),
 		Description: Str("Reflected XSS in search parameter"),
 		Active:      Bool(true),
 		Verified:    Bool(false),
@@ -140,6 +142,344 @@ func TestFindingsService_Read(t *testing.T) {
 	}
 }
 
+func TestFindingsService_Create(t *testing.T) {
+	response := `{
+		"id": 10,
+		"title": "Manual finding",
+		"severity": "Medium",
+		"description": "Found during review",
+		"active": true,
+		"verified": true,
+		"numerical_severity": "S2",
+		"test": 3,
+		"found_by": [1]
+	}`
+
+	expected := Finding{
+		Id:                Int(10),
+		Title:             Str("Manual finding"),
+		Severity:          Ptr(SeverityMedium),
+		Description:       Str("Found during review"),
+		Active:            Bool(true),
+		Verified:          Bool(true),
+		NumericalSeverity: Str("S2"),
+		Test:              Int(3),
+		FoundBy:           &[]int{1},
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("Expected POST request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/findings/") {
+			t.Errorf("Expected /findings/ in path, got %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusCreated)
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.Findings.Create(context.Background(), &Finding{
+		Title:             Str("Manual finding"),
+		Severity:          Ptr(SeverityMedium),
+		Description:       Str("Found during review"),
+		Active:            Bool(true),
+		Verified:          Bool(true),
+		NumericalSeverity: Str("S2"),
+		Test:              Int(3),
+		FoundBy:           &[]int{1},
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestFindingsService_Delete(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("Expected DELETE request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/findings/10/") {
+			t.Errorf("Expected /findings/10/ in path, got %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	_, err := dj.Findings.Delete(context.Background(), 10)
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+}
+
+func TestFindingsService_Close(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v2/findings/10/close/":
+			var body map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("cannot decode body: %s", err)
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			if body["is_mitigated"] != true || body["false_p"] != false || body["note"] != "Fixed in 1.2.3" {
+				t.Errorf("unexpected close body %v", body)
+			}
+			_, _ = fmt.Fprintln(w, `{"is_mitigated": true}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v2/findings/10/":
+			_, _ = fmt.Fprintln(w, `{"id": 10, "active": false, "is_mitigated": true}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.Findings.Close(context.Background(), 10, "Fixed in 1.2.3")
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	expected := &Finding{Id: Int(10), Active: Bool(false), IsMitigated: Bool(true)}
+	if !cmp.Equal(actual, expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, expected)
+	}
+}
+
+func TestFindingsService_statusTransitions(t *testing.T) {
+	tests := []struct {
+		name     string
+		call     func(*FindingsService) (*Finding, error)
+		expected string
+	}{
+		{
+			name:     "false positive",
+			call:     func(s *FindingsService) (*Finding, error) { return s.MarkFalsePositive(context.Background(), 7) },
+			expected: `{"active":false,"verified":false,"false_p":true}`,
+		},
+		{
+			name:     "out of scope",
+			call:     func(s *FindingsService) (*Finding, error) { return s.MarkOutOfScope(context.Background(), 7) },
+			expected: `{"active":false,"verified":false,"out_of_scope":true}`,
+		},
+		{
+			name:     "reopen",
+			call:     func(s *FindingsService) (*Finding, error) { return s.Reopen(context.Background(), 7) },
+			expected: `{"active":true,"false_p":false,"out_of_scope":false,"is_mitigated":false}`,
+		},
+		{
+			name:     "verify",
+			call:     func(s *FindingsService) (*Finding, error) { return s.Verify(context.Background(), 7) },
+			expected: `{"verified":true}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPatch {
+					t.Errorf("Expected PATCH request, got %s", r.Method)
+				}
+				if !strings.Contains(r.URL.Path, "/findings/7/") {
+					t.Errorf("Expected /findings/7/ in path, got %s", r.URL.Path)
+				}
+				b, _ := io.ReadAll(r.Body)
+				if string(b) != tt.expected {
+					t.Errorf("expected body %s, got %s", tt.expected, b)
+				}
+				_, _ = fmt.Fprintln(w, `{"id": 7}`)
+			}))
+			defer ts.Close()
+
+			dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+			actual, err := tt.call(dj.Findings)
+			if !cmp.Equal(err, nil) {
+				t.Errorf("error: %s", err)
+			}
+			if *actual.Id != 7 {
+				t.Errorf("expected finding 7, got %d", *actual.Id)
+			}
+		})
+	}
+}
+
+func TestFindingsService_notes(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v2/findings/5/notes/":
+			_, _ = fmt.Fprintln(w, `{"finding_id": 5, "notes": [{"id": 1, "entry": "First"}, {"id": 2, "entry": "Second", "private": true}]}`)
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v2/findings/5/notes/":
+			b, _ := io.ReadAll(r.Body)
+			if string(b) != `{"entry":"Third","private":false}` {
+				t.Errorf("unexpected body %s", b)
+			}
+			w.WriteHeader(http.StatusCreated)
+			_, _ = fmt.Fprintln(w, `{"id": 3, "entry": "Third", "private": false}`)
+		case r.Method == http.MethodPatch && r.URL.Path == "/api/v2/findings/5/remove_note/":
+			b, _ := io.ReadAll(r.Body)
+			if string(b) != `{"note_id":3}` {
+				t.Errorf("unexpected body %s", b)
+			}
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+	ctx := context.Background()
+
+	notes, err := dj.Findings.ListNotes(ctx, 5)
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+	expected := &FindingNotes{
+		FindingId: Int(5),
+		Notes:     &[]Note{{Id: Int(1), Entry: Str("First")}, {Id: Int(2), Entry: Str("Second"), Private: Bool(true)}},
+	}
+	if !cmp.Equal(notes, expected) {
+		t.Errorf("should have been equal, %+v, %+v", notes, expected)
+	}
+
+	note, err := dj.Findings.AddNote(ctx, 5, &NewNote{Entry: Str("Third"), Private: Bool(false)})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+	if !cmp.Equal(note, &Note{Id: Int(3), Entry: Str("Third"), Private: Bool(false)}) {
+		t.Errorf("unexpected note %+v", note)
+	}
+
+	if err := dj.Findings.RemoveNote(ctx, 5, 3); !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+}
+
+func TestFindingsService_tags(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v2/findings/5/tags/":
+			if string(b) != `{"tags":["pci","triaged"]}` {
+				t.Errorf("unexpected body %s", b)
+			}
+			_, _ = fmt.Fprintln(w, `{"tags": ["existing", "pci", "triaged"]}`)
+		case r.Method == http.MethodPut && r.URL.Path == "/api/v2/findings/5/remove_tags/":
+			if string(b) != `{"tags":["existing"]}` {
+				t.Errorf("unexpected body %s", b)
+			}
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+	ctx := context.Background()
+
+	tags, err := dj.Findings.AddTags(ctx, 5, []string{"pci", "triaged"})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+	if !cmp.Equal(tags, &FindingTags{Tags: &[]string{"existing", "pci", "triaged"}}) {
+		t.Errorf("unexpected tags %+v", tags)
+	}
+
+	if err := dj.Findings.RemoveTags(ctx, 5, []string{"existing"}); !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+}
+
+func TestFindingsService_files(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v2/findings/5/files/":
+			if err := r.ParseMultipartForm(1 << 20); err != nil {
+				t.Errorf("cannot parse multipart form: %s", err)
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			f, fh, err := r.FormFile("file")
+			if err != nil {
+				t.Errorf("missing file: %s", err)
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			b, _ := io.ReadAll(f)
+			if fh.Filename != "poc.txt" || string(b) != "proof" || r.FormValue("title") != "PoC" {
+				t.Errorf("unexpected upload %s %s %s", fh.Filename, b, r.FormValue("title"))
+			}
+			w.WriteHeader(http.StatusCreated)
+			_, _ = fmt.Fprintln(w, `{"id": 9, "file": "https://dojo/media/poc.txt", "title": "PoC"}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v2/findings/5/files/download/9/":
+			w.Header().Set("Content-Type", "text/plain")
+			_, _ = fmt.Fprint(w, "proof")
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+	ctx := context.Background()
+
+	file, err := dj.Findings.AddFile(ctx, 5, "PoC", &FileUpload{Name: "poc.txt", Reader: strings.NewReader("proof")})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+	expected := &File{Id: Int(9), File: Str("https://dojo/media/poc.txt"), Title: Str("PoC")}
+	if !cmp.Equal(file, expected) {
+		t.Errorf("should have been equal, %+v, %+v", file, expected)
+	}
+
+	var sb strings.Builder
+	if err := dj.Findings.DownloadFile(ctx, 5, 9, &sb); !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+	if sb.String() != "proof" {
+		t.Errorf("unexpected contents %s", sb.String())
+	}
+}
+
+func TestFindingsService_AddRequestResponses(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("Expected POST request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/findings/5/request_response/") {
+			t.Errorf("Expected /findings/5/request_response/ in path, got %s", r.URL.Path)
+		}
+		b, _ := io.ReadAll(r.Body)
+		if string(b) != `{"req_resp":[{"request":"GET / HTTP/1.1","response":"HTTP/1.1 200 OK"}]}` {
+			t.Errorf("unexpected body %s", b)
+		}
+		_, _ = fmt.Fprint(w, string(b))
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	pairs := []RequestResponse{{Request: Str("GET / HTTP/1.1"), Response: Str("HTTP/1.1 200 OK")}}
+	actual, err := dj.Findings.AddRequestResponses(context.Background(), 5, pairs)
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+	if !cmp.Equal(actual, &FindingRequestResponses{ReqResp: &pairs}) {
+		t.Errorf("unexpected response %+v", actual)
+	}
+}
+
 func TestFindingsOptions_ToString(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -149,7 +489,7 @@ func TestFindingsOptions_ToString(t *testing.T) {
 		{
 			name: "severity only",
 			options: &FindingsOptions{
-				Severity: "Critical",
+				Severity: SeverityCritical,
 			},
 			expected: "?severity=Critical",
 		},
@@ -159,12 +499,37 @@ func TestFindingsOptions_ToString(t *testing.T) {
 				Limit:    10,
 				Offset:   20,
 				Title:    "SQL",
-				Severity: "High",
+				Severity: SeverityHigh,
 				Active:   "true",
 				Verified: "false",
 				Prefetch: "test",
 			},
-			expected: "?limit=10&offset=20&title=SQL&severity=High&active=true&verified=false&prefetch=test",
+			expected: "?active=true&limit=10&offset=20&prefetch=test&severity=High&title=SQL&verified=false",
+		},
+		{
+			name: "relations and ordering",
+			options: &FindingsOptions{
+				Product:       3,
+				Engagement:    4,
+				Tags:          []string{"pci", "prod"},
+				Ordering:      "-severity",
+				RelatedFields: true,
+			},
+			expected: "?o=-severity&related_fields=true&tags=pci%2Cprod&test__engagement=4&test__engagement__product=3",
+		},
+		{
+			name: "values are escaped",
+			options: &FindingsOptions{
+				Title:           "XSS & SQLi in /search?q=",
+				VulnerabilityID: "CVE-2021-44228",
+				DiscoveredAfter: "2024-01-01",
+			},
+			expected: "?discovered_after=2024-01-01&title=XSS+%26+SQLi+in+%2Fsearch%3Fq%3D&vulnerability_id=CVE-2021-44228",
+		},
+		{
+			name:     "nil options",
+			options:  nil,
+			expected: "",
 		},
 	}
 
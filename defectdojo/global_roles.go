@@ -0,0 +1,195 @@
+package defectdojo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+type GlobalRolesService struct {
+	client *Client
+}
+
+type GlobalRole struct {
+	Id    *int    `json:"id,omitempty"`
+	User  *int    `json:"user,omitempty"`
+	Group *int    `json:"group,omitempty"`
+	Role  *RoleID `json:"role,omitempty"`
+}
+
+type GlobalRoles struct {
+	Count    *int          `json:"count,omitempty"`
+	Next     *string       `json:"next,omitempty"`
+	Previous *string       `json:"previous,omitempty"`
+	Results  *[]GlobalRole `json:"results,omitempty"`
+}
+
+type GlobalRolesOptions struct {
+	Limit  int
+	Offset int
+	ID     int
+	User   int
+	Group  int
+	Role   RoleID
+}
+
+func (o *GlobalRolesOptions) ToString() string {
+	if o == nil {
+		return ""
+	}
+
+	v := url.Values{}
+	if o.Limit > 0 {
+		v.Set("limit", strconv.Itoa(o.Limit))
+	}
+	if o.Offset > 0 {
+		v.Set("offset", strconv.Itoa(o.Offset))
+	}
+	if o.ID > 0 {
+		v.Set("id", strconv.Itoa(o.ID))
+	}
+	if o.User > 0 {
+		v.Set("user", strconv.Itoa(o.User))
+	}
+	if o.Group > 0 {
+		v.Set("group", strconv.Itoa(o.Group))
+	}
+	if o.Role > 0 {
+		v.Set("role", strconv.Itoa(int(o.Role)))
+	}
+
+	return "?" + v.Encode()
+}
+
+func (c *GlobalRolesService) List(ctx context.Context, options *GlobalRolesOptions) (*GlobalRoles, error) {
+	path := fmt.Sprintf("%s/global_roles/%s", c.client.BaseURL, options.ToString())
+
+	req, err := http.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := GlobalRoles{}
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// All returns an iterator over all global roles matching the given options,
+// fetching further pages from DefectDojo as the iteration advances.
+func (c *GlobalRolesService) All(ctx context.Context, options *GlobalRolesOptions) iter.Seq2[GlobalRole, error] {
+	path := fmt.Sprintf("%s/global_roles/%s", c.client.BaseURL, options.ToString())
+
+	return all[GlobalRole](ctx, c.client, path)
+}
+
+func (c *GlobalRolesService) Read(ctx context.Context, id int) (*GlobalRole, error) {
+	path := fmt.Sprintf("%s/global_roles/%d/", c.client.BaseURL, id)
+
+	req, err := http.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(GlobalRole)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *GlobalRolesService) Create(ctx context.Context, u *GlobalRole) (*GlobalRole, error) {
+	path := fmt.Sprintf("%s/global_roles/", c.client.BaseURL)
+
+	postJSON, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPost, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(GlobalRole)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *GlobalRolesService) Update(ctx context.Context, id int, u *GlobalRole) (*GlobalRole, error) {
+	path := fmt.Sprintf("%s/global_roles/%d/", c.client.BaseURL, id)
+
+	postJSON, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPut, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(GlobalRole)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *GlobalRolesService) PartialUpdate(ctx context.Context, id int, u *GlobalRole) (*GlobalRole, error) {
+	path := fmt.Sprintf("%s/global_roles/%d/", c.client.BaseURL, id)
+
+	postJSON, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPatch, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(GlobalRole)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *GlobalRolesService) Delete(ctx context.Context, id int) (*GlobalRole, error) {
+	path := fmt.Sprintf("%s/global_roles/%d/", c.client.BaseURL, id)
+
+	req, err := http.NewRequest(http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(GlobalRole)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
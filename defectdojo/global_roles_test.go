@@ -0,0 +1,285 @@
+package defectdojo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestGlobalRolesService_List(t *testing.T) {
+	response := `{
+		"count": 1,
+		"next": null,
+		"previous": null,
+		"results": [
+			{
+				"id": 4,
+				"user": 7,
+				"group": 11,
+				"role": 3
+			}
+		]
+	}`
+
+	expected := GlobalRoles{
+		Count:    Int(1),
+		Next:     nil,
+		Previous: nil,
+		Results: &[]GlobalRole{
+			{
+				Id:    Int(4),
+				User:  Int(7),
+				Group: Int(11),
+				Role:  Ptr(RoleMaintainer),
+			},
+		},
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected GET request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/global_roles/") {
+			t.Errorf("Expected /global_roles/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.GlobalRoles.List(context.Background(), &GlobalRolesOptions{
+		Limit: 10,
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestGlobalRolesService_Read(t *testing.T) {
+	response := `{
+		"id": 4,
+		"user": 7,
+		"group": 11,
+		"role": 3
+	}`
+
+	expected := GlobalRole{
+		Id:    Int(4),
+		User:  Int(7),
+		Group: Int(11),
+		Role:  Ptr(RoleMaintainer),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected GET request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/global_roles/4/") {
+			t.Errorf("Expected /global_roles/4/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.GlobalRoles.Read(context.Background(), 4)
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestGlobalRolesService_Create(t *testing.T) {
+	response := `{
+		"id": 4,
+		"user": 7,
+		"group": 11,
+		"role": 3
+	}`
+
+	expected := GlobalRole{
+		Id:    Int(4),
+		User:  Int(7),
+		Group: Int(11),
+		Role:  Ptr(RoleMaintainer),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("Expected POST request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/global_roles/") {
+			t.Errorf("Expected /global_roles/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.GlobalRoles.Create(context.Background(), &GlobalRole{
+		User:  Int(7),
+		Group: Int(11),
+		Role:  Ptr(RoleMaintainer),
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestGlobalRolesService_Update(t *testing.T) {
+	response := `{
+		"id": 4,
+		"user": 7,
+		"group": 11,
+		"role": 3
+	}`
+
+	expected := GlobalRole{
+		Id:    Int(4),
+		User:  Int(7),
+		Group: Int(11),
+		Role:  Ptr(RoleMaintainer),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Errorf("Expected PUT request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/global_roles/4/") {
+			t.Errorf("Expected /global_roles/4/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.GlobalRoles.Update(context.Background(), 4, &GlobalRole{
+		User:  Int(7),
+		Group: Int(11),
+		Role:  Ptr(RoleMaintainer),
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestGlobalRolesService_PartialUpdate(t *testing.T) {
+	response := `{
+		"id": 4,
+		"user": 7,
+		"group": 11,
+		"role": 3
+	}`
+
+	expected := GlobalRole{
+		Id:    Int(4),
+		User:  Int(7),
+		Group: Int(11),
+		Role:  Ptr(RoleMaintainer),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch {
+			t.Errorf("Expected PATCH request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/global_roles/4/") {
+			t.Errorf("Expected /global_roles/4/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.GlobalRoles.PartialUpdate(context.Background(), 4, &GlobalRole{
+		User:  Int(7),
+		Group: Int(11),
+		Role:  Ptr(RoleMaintainer),
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestGlobalRolesService_Delete(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("Expected DELETE request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/global_roles/4/") {
+			t.Errorf("Expected /global_roles/4/ in path, got %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	_, err := dj.GlobalRoles.Delete(context.Background(), 4)
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+}
+
+func TestGlobalRolesOptions_ToString(t *testing.T) {
+	tests := []struct {
+		name     string
+		options  *GlobalRolesOptions
+		expected string
+	}{
+		{
+			name: "user only",
+			options: &GlobalRolesOptions{
+				User: 7,
+			},
+			expected: "?user=7",
+		},
+		{
+			name: "all fields",
+			options: &GlobalRolesOptions{
+				Limit: 10,
+				User:  7,
+				Group: 11,
+				Role:  3,
+			},
+			expected: "?group=11&limit=10&role=3&user=7",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := tt.options.ToString()
+			if actual != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package defectdojo
+
+import (
+	"context"
+	"fmt"
+)
+
+type ImportLanguagesService struct {
+	client *Client
+}
+
+// ImportLanguages uploads a cloc report, produced with cloc --json, as the language statistics of a product.
+// The statistics replace the ones previously imported for the product.
+type ImportLanguages struct {
+	Product *int    `json:"product,omitempty"`
+	File    *string `json:"file,omitempty"`
+	// FileUpload, when set, is streamed as the cloc report instead of the file at File
+	FileUpload *FileUpload `json:"-"`
+}
+
+func (c *ImportLanguagesService) Create(ctx context.Context, m *ImportLanguages) (*ImportLanguages, error) {
+	path := fmt.Sprintf("%s/import-languages/", c.client.BaseURL)
+
+	up, err := structTagToMap(*m)
+	if err != nil {
+		return nil, err
+	}
+	req, err := newFileUploadRequest(path, &up, m.FileUpload)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(ImportLanguages)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
@@ -0,0 +1,64 @@
+package defectdojo
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestImportLanguagesService_Create(t *testing.T) {
+	report := `{"header": {"cloc_version": "1.98"}, "Go": {"nFiles": 120, "blank": 1500, "comment": 900, "code": 15000}}`
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("Expected POST request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/import-languages/") {
+			t.Errorf("Expected /import-languages/ in path, got %s", r.URL.Path)
+		}
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			t.Errorf("cannot parse multipart form: %s", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if r.FormValue("product") != "3" {
+			t.Errorf("Expected product 3, got %s", r.FormValue("product"))
+		}
+		f, fh, err := r.FormFile("file")
+		if err != nil {
+			t.Errorf("missing file: %s", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		b, _ := io.ReadAll(f)
+		if fh.Filename != "cloc.json" || string(b) != report {
+			t.Errorf("unexpected file %s: %s", fh.Filename, b)
+		}
+		_, _ = fmt.Fprintln(w, `{"product": 3}`)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.ImportLanguages.Create(context.Background(), &ImportLanguages{
+		Product: Int(3),
+		FileUpload: &FileUpload{
+			Name:   "cloc.json",
+			Reader: strings.NewReader(report),
+		},
+	})
+	if !cmp.Equal(err, nil) {
+		t.Fatalf("error: %s", err)
+	}
+
+	expected := &ImportLanguages{Product: Int(3)}
+	if !cmp.Equal(actual, expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, expected)
+	}
+}
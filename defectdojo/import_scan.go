@@ -1,7 +1,6 @@
 package defectdojo
 
 import (
-	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -9,8 +8,10 @@ import (
 	"mime/multipart"
 	"net/http"
 	"os"
+	"path/filepath"
 	"reflect"
 	"strings"
+	"sync"
 )
 
 type ImportScanService struct {
@@ -18,56 +19,139 @@ type ImportScanService struct {
 }
 
 type ImportScan struct {
-	ScanDate             *string   `json:"scan_date,omitempty"`
-	MinimumSeverity      *string   `json:"minimum_severity,omitempty"`
-	Active               *bool     `json:"active,omitempty"`
-	Verified             *bool     `json:"verified,omitempty"`
-	ScanType             *string   `json:"scan_type,omitempty"`
-	EndpointToAdd        *int      `json:"endpoint_to_add,omitempty"`
-	File                 *string   `json:"file,omitempty"`
-	ProductTypeName      *string   `json:"product_type_name,omitempty"`
-	ProductName          *string   `json:"product_name,omitempty"`
-	EngagementName       *string   `json:"engagement_name,omitempty"`
-	Engagement           *int      `json:"engagement,omitempty"`
-	TestTitle            *string   `json:"test_title,omitempty"`
-	AutoCreateContext    *bool     `json:"auto_create_context,omitempty"`
-	DeduplicationOnEngagement  *bool     `json:"deduplication_on_engagement,omitempty"`
-	Lead                 *int      `json:"lead,omitempty"`
-	Tags                 *[]string `json:"tags,omitempty"`
-	CloseOldFindings     *bool     `json:"close_old_findings,omitempty"`
-	PushToJira           *bool     `json:"push_to_jira,omitempty"`
-	Environment          *string   `json:"environment,omitempty"`
-	Version              *string   `json:"version,omitempty"`
-	BuildId              *string   `json:"build_id,omitempty"`
-	BranchTag            *string   `json:"branch_tag,omitempty"`
-	CommitHash           *string   `json:"commit_hash,omitempty"`
-	ApiScanConfiguration *int      `json:"api_scan_configuration,omitempty"`
-	Service              *string   `json:"service,omitempty"`
-	GroupBy              *string   `json:"group_by,omitempty"`
-	Test                 *int      `json:"test,omitempty"`
-	TestId               *int      `json:"test_id,omitempty"`
-	EngagementId         *int      `json:"engagement_id,omitempty"`
-	ProductId            *int      `json:"product_id,omitempty"`
-	ProductTypeId        *int      `json:"product_type_id,omitempty"`
+	ScanDate                  *string   `json:"scan_date,omitempty"`
+	MinimumSeverity           *Severity `json:"minimum_severity,omitempty"`
+	Active                    *bool     `json:"active,omitempty"`
+	Verified                  *bool     `json:"verified,omitempty"`
+	ScanType                  *string   `json:"scan_type,omitempty"`
+	EndpointToAdd             *int      `json:"endpoint_to_add,omitempty"`
+	File                      *string   `json:"file,omitempty"`
+	ProductTypeName           *string   `json:"product_type_name,omitempty"`
+	ProductName               *string   `json:"product_name,omitempty"`
+	EngagementName            *string   `json:"engagement_name,omitempty"`
+	Engagement                *int      `json:"engagement,omitempty"`
+	TestTitle                 *string   `json:"test_title,omitempty"`
+	AutoCreateContext         *bool     `json:"auto_create_context,omitempty"`
+	DeduplicationOnEngagement *bool     `json:"deduplication_on_engagement,omitempty"`
+	Lead                      *int      `json:"lead,omitempty"`
+	Tags                      *[]string `json:"tags,omitempty"`
+	CloseOldFindings          *bool     `json:"close_old_findings,omitempty"`
+	PushToJira                *bool     `json:"push_to_jira,omitempty"`
+	Environment               *string   `json:"environment,omitempty"`
+	Version                   *string   `json:"version,omitempty"`
+	BuildId                   *string   `json:"build_id,omitempty"`
+	BranchTag                 *string   `json:"branch_tag,omitempty"`
+	CommitHash                *string   `json:"commit_hash,omitempty"`
+	ApiScanConfiguration      *int      `json:"api_scan_configuration,omitempty"`
+	Service                   *string   `json:"service,omitempty"`
+	GroupBy                   *string   `json:"group_by,omitempty"`
+	Test                      *int      `json:"test,omitempty"`
+	TestId                    *int      `json:"test_id,omitempty"`
+	EngagementId              *int      `json:"engagement_id,omitempty"`
+	ProductId                 *int      `json:"product_id,omitempty"`
+	ProductTypeId             *int      `json:"product_type_id,omitempty"`
+	// FileUpload, when set, is streamed as the scan report instead of the file at File
+	FileUpload *FileUpload `json:"-"`
+}
+
+// ImportScanResult is the response of DefectDojo to a scan import.
+type ImportScanResult struct {
+	ScanDate                          *string           `json:"scan_date,omitempty"`
+	MinimumSeverity                   *Severity         `json:"minimum_severity,omitempty"`
+	Active                            *bool             `json:"active,omitempty"`
+	Verified                          *bool             `json:"verified,omitempty"`
+	ScanType                          *string           `json:"scan_type,omitempty"`
+	EndpointToAdd                     *int              `json:"endpoint_to_add,omitempty"`
+	File                              *string           `json:"file,omitempty"`
+	ProductTypeName                   *string           `json:"product_type_name,omitempty"`
+	ProductName                       *string           `json:"product_name,omitempty"`
+	EngagementName                    *string           `json:"engagement_name,omitempty"`
+	EngagementEndDate                 *string           `json:"engagement_end_date,omitempty"`
+	SourceCodeManagementUri           *string           `json:"source_code_management_uri,omitempty"`
+	Engagement                        *int              `json:"engagement,omitempty"`
+	TestTitle                         *string           `json:"test_title,omitempty"`
+	AutoCreateContext                 *bool             `json:"auto_create_context,omitempty"`
+	DeduplicationOnEngagement         *bool             `json:"deduplication_on_engagement,omitempty"`
+	Lead                              *int              `json:"lead,omitempty"`
+	Tags                              *[]string         `json:"tags,omitempty"`
+	CloseOldFindings                  *bool             `json:"close_old_findings,omitempty"`
+	CloseOldFindingsProductScope      *bool             `json:"close_old_findings_product_scope,omitempty"`
+	PushToJira                        *bool             `json:"push_to_jira,omitempty"`
+	Environment                       *string           `json:"environment,omitempty"`
+	Version                           *string           `json:"version,omitempty"`
+	BuildId                           *string           `json:"build_id,omitempty"`
+	BranchTag                         *string           `json:"branch_tag,omitempty"`
+	CommitHash                        *string           `json:"commit_hash,omitempty"`
+	ApiScanConfiguration              *int              `json:"api_scan_configuration,omitempty"`
+	Service                           *string           `json:"service,omitempty"`
+	GroupBy                           *string           `json:"group_by,omitempty"`
+	CreateFindingGroupsForAllFindings *bool             `json:"create_finding_groups_for_all_findings,omitempty"`
+	Test                              *int              `json:"test,omitempty"`
+	TestId                            *int              `json:"test_id,omitempty"`
+	EngagementId                      *int              `json:"engagement_id,omitempty"`
+	ProductId                         *int              `json:"product_id,omitempty"`
+	ProductTypeId                     *int              `json:"product_type_id,omitempty"`
+	Statistics                        *ImportStatistics `json:"statistics,omitempty"`
+}
+
+// ImportStatistics reports the findings of a test before and after an import,
+// and what the import changed.
+type ImportStatistics struct {
+	Before *SeverityStatistics    `json:"before,omitempty"`
+	Delta  *ImportStatisticsDelta `json:"delta,omitempty"`
+	After  *SeverityStatistics    `json:"after,omitempty"`
+}
+
+// ImportStatisticsDelta breaks down the findings affected by an import.
+type ImportStatisticsDelta struct {
+	Created     *SeverityStatistics `json:"created,omitempty"`
+	Closed      *SeverityStatistics `json:"closed,omitempty"`
+	Reactivated *SeverityStatistics `json:"reactivated,omitempty"`
+	Untouched   *SeverityStatistics `json:"untouched,omitempty"`
+}
+
+// SeverityStatistics counts findings per severity.
+type SeverityStatistics struct {
+	Info     *FindingStatistics `json:"info,omitempty"`
+	Low      *FindingStatistics `json:"low,omitempty"`
+	Medium   *FindingStatistics `json:"medium,omitempty"`
+	High     *FindingStatistics `json:"high,omitempty"`
+	Critical *FindingStatistics `json:"critical,omitempty"`
+	Total    *FindingStatistics `json:"total,omitempty"`
+}
+
+// FindingStatistics counts findings by status.
+type FindingStatistics struct {
+	Active       *int `json:"active,omitempty"`
+	Verified     *int `json:"verified,omitempty"`
+	Duplicate    *int `json:"duplicate,omitempty"`
+	FalseP       *int `json:"false_p,omitempty"`
+	OutOfScope   *int `json:"out_of_scope,omitempty"`
+	IsMitigated  *int `json:"is_mitigated,omitempty"`
+	RiskAccepted *int `json:"risk_accepted,omitempty"`
+	Total        *int `json:"total,omitempty"`
 }
 
 type importScanMap map[string]string
 
-func (c *ImportScanService) Create(ctx context.Context, m *ImportScan) (*ImportScan, error) {
+func (c *ImportScanService) Create(ctx context.Context, m *ImportScan) (*ImportScanResult, error) {
 	path := fmt.Sprintf("%s/import-scan/", c.client.BaseURL)
 
+	if err := c.client.checkScanType(m.ScanType); err != nil {
+		return nil, err
+	}
 	up, err := structTagToMap(*m)
 	if err != nil {
 		return nil, err
 	}
-	req, err := newFileUploadRequest(path, &up)
+	req, err := newFileUploadRequest(path, &up, m.FileUpload)
 	if err != nil {
 		return nil, err
 	}
 
 	req = req.WithContext(ctx)
 
-	res := new(ImportScan)
+	res := new(ImportScanResult)
 	if err := c.client.sendRequest(req, &res); err != nil {
 		return nil, err
 	}
@@ -75,60 +159,151 @@ func (c *ImportScanService) Create(ctx context.Context, m *ImportScan) (*ImportS
 	return res, nil
 }
 
-func newFileUploadRequest(uri string, params *importScanMap) (*http.Request, error) {
-	body := new(bytes.Buffer)
-	writer := multipart.NewWriter(body)
+// FileUpload is a file streamed to DefectDojo from an io.Reader rather than read from disk.
+// If Reader also implements io.Seeker, the upload can be replayed when a request is retried.
+type FileUpload struct {
+	// Name is the file name reported to DefectDojo
+	Name string
+	// Reader provides the contents of the file
+	Reader io.Reader
+}
 
-	for key, val := range *params {
-		switch key {
-		case "file":
-			file, err := os.Open(val)
-			if err != nil {
-				return nil, err
-			}
-			fileContents, err := io.ReadAll(file)
-			if err != nil {
-				return nil, err
-			}
-			fi, err := file.Stat()
-			if err != nil {
-				return nil, err
-			}
-			err = file.Close()
-			if err != nil {
-				return nil, err
-			}
-			part, err := writer.CreateFormFile(key, fi.Name())
+// newFileUploadRequest builds a multipart POST request to uri from params.
+// The "file" parameter, or upload when set, is streamed to the request body
+// through a pipe instead of being buffered in memory.
+func newFileUploadRequest(uri string, params *importScanMap, upload *FileUpload) (*http.Request, error) {
+	var open func() (string, io.ReadCloser, error)
+
+	switch path, ok := (*params)["file"]; {
+	case upload != nil:
+		if upload.Reader == nil {
+			return nil, errors.New("newFileUploadRequest: file upload has no reader")
+		}
+		open = upload.open
+	case ok:
+		open = func() (string, io.ReadCloser, error) {
+			f, err := os.Open(path)
 			if err != nil {
-				return nil, err
+				return "", nil, err
 			}
-			_, err = part.Write(fileContents)
-			if err != nil {
+			return filepath.Base(path), f, nil
+		}
+	}
+
+	boundary := multipart.NewWriter(nil).Boundary()
+
+	var (
+		mu   sync.Mutex
+		prev *io.PipeReader
+		done chan struct{}
+	)
+
+	newBody := func() (io.ReadCloser, error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		// the writer of a previous attempt may still be copying the file: stop it and wait
+		// for it to return, so that the file is not rewound under its feet
+		if prev != nil {
+			_ = prev.Close()
+			<-done
+		}
+
+		var name string
+		var file io.ReadCloser
+		if open != nil {
+			var err error
+			if name, file, err = open(); err != nil {
 				return nil, err
 			}
-		case "tags":
-			t := strings.Trim(val, "[")
-			t = strings.Trim(t, "]")
-			_ = writer.WriteField(key, t)
-		default:
-			_ = writer.WriteField(key, val)
 		}
+
+		pr, pw := io.Pipe()
+		prev, done = pr, make(chan struct{})
+		go func(done chan struct{}) {
+			defer close(done)
+			pw.CloseWithError(writeMultipart(pw, boundary, params, name, file))
+		}(done)
+
+		return pr, nil
 	}
 
-	err := writer.Close()
+	body, err := newBody()
 	if err != nil {
 		return nil, err
 	}
 
-	r, err := http.NewRequest("POST", uri, body)
+	r, err := http.NewRequest(http.MethodPost, uri, body)
 	if err != nil {
+		_ = body.Close()
 		return nil, err
 	}
-	r.Header.Set("Content-Type", writer.FormDataContentType())
+	r.Header.Set("Content-Type", "multipart/form-data; boundary="+boundary)
+
+	if upload == nil || upload.seekable() {
+		r.GetBody = newBody
+	}
 
 	return r, nil
 }
 
+// writeMultipart encodes params as a multipart form into w, streaming file under the "file" field.
+func writeMultipart(w io.Writer, boundary string, params *importScanMap, name string, file io.ReadCloser) error {
+	if file != nil {
+		defer func() { _ = file.Close() }()
+	}
+
+	writer := multipart.NewWriter(w)
+	if err := writer.SetBoundary(boundary); err != nil {
+		return err
+	}
+
+	for key, val := range *params {
+		var err error
+		switch key {
+		case "file":
+			continue
+		case "tags":
+			t := strings.Trim(val, "[")
+			t = strings.Trim(t, "]")
+			err = writer.WriteField(key, t)
+		default:
+			err = writer.WriteField(key, val)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if file != nil {
+		part, err := writer.CreateFormFile("file", name)
+		if err != nil {
+			return err
+		}
+		if _, err := io.Copy(part, file); err != nil {
+			return err
+		}
+	}
+
+	return writer.Close()
+}
+
+func (u *FileUpload) seekable() bool {
+	_, ok := u.Reader.(io.Seeker)
+	return ok
+}
+
+// open rewinds the reader if possible and returns it with the file name.
+// The returned ReadCloser does not close the underlying reader.
+func (u *FileUpload) open() (string, io.ReadCloser, error) {
+	if s, ok := u.Reader.(io.Seeker); ok {
+		if _, err := s.Seek(0, io.SeekStart); err != nil {
+			return "", nil, err
+		}
+	}
+	return u.Name, io.NopCloser(u.Reader), nil
+}
+
 func structTagToMap(in interface{}) (importScanMap, error) {
 	m := make(importScanMap)
 
@@ -145,11 +320,19 @@ func structTagToMap(in interface{}) (importScanMap, error) {
 		if len(tag) == 0 {
 			return nil, errors.New("tag not found")
 		}
+		if tag == "-" {
+			continue
+		}
 
 		value := v.Field(i).Interface()
 		if v.Field(i).IsZero() {
 			continue
 		}
+		if f, ok := value.(validator); ok {
+			if err := f.Validate(); err != nil {
+				return nil, err
+			}
+		}
 		if v.Field(i).Kind() == reflect.Ptr {
 			value = v.Field(i).Elem()
 		}
@@ -0,0 +1,114 @@
+package defectdojo
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestImportScanService_Create(t *testing.T) {
+	type upload struct {
+		name, contents, scanType, tags string
+	}
+
+	newServer := func(t *testing.T, got *upload) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPost {
+				t.Errorf("Expected POST request, got %s", r.Method)
+			}
+			if !strings.Contains(r.URL.Path, "/import-scan/") {
+				t.Errorf("Expected /import-scan/ in path, got %s", r.URL.Path)
+			}
+			if err := r.ParseMultipartForm(1 << 20); err != nil {
+				t.Errorf("cannot parse multipart form: %s", err)
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			f, fh, err := r.FormFile("file")
+			if err != nil {
+				t.Errorf("missing file: %s", err)
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			b, _ := io.ReadAll(f)
+			*got = upload{
+				name:     fh.Filename,
+				contents: string(b),
+				scanType: r.FormValue("scan_type"),
+				tags:     r.FormValue("tags"),
+			}
+			_, _ = fmt.Fprintln(w, `{"test": 1}`)
+		}))
+	}
+
+	t.Run("file path", func(t *testing.T) {
+		file := filepath.Join(t.TempDir(), "trivy.json")
+		if err := os.WriteFile(file, []byte(`{"Results": []}`), 0o600); err != nil {
+			t.Fatal(err)
+		}
+
+		var got upload
+		ts := newServer(t, &got)
+		defer ts.Close()
+
+		dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+		_, err := dj.ImportScan.Create(context.Background(), &ImportScan{
+			ScanType: Str("Trivy Scan"),
+			File:     Str(file),
+			Tags:     Slice([]string{"a", "b"}),
+		})
+		if !cmp.Equal(err, nil) {
+			t.Fatalf("error: %s", err)
+		}
+
+		expected := upload{name: "trivy.json", contents: `{"Results": []}`, scanType: "Trivy Scan", tags: "a b"}
+		if !cmp.Equal(got, expected, cmp.AllowUnexported(upload{})) {
+			t.Errorf("should have been equal, %+v, %+v", got, expected)
+		}
+	})
+
+	t.Run("reader", func(t *testing.T) {
+		var got upload
+		ts := newServer(t, &got)
+		defer ts.Close()
+
+		dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+		_, err := dj.ImportScan.Create(context.Background(), &ImportScan{
+			ScanType: Str("ZAP Scan"),
+			FileUpload: &FileUpload{
+				Name:   "zap.xml",
+				Reader: strings.NewReader("<OWASPZAPReport/>"),
+			},
+		})
+		if !cmp.Equal(err, nil) {
+			t.Fatalf("error: %s", err)
+		}
+
+		expected := upload{name: "zap.xml", contents: "<OWASPZAPReport/>", scanType: "ZAP Scan"}
+		if !cmp.Equal(got, expected, cmp.AllowUnexported(upload{})) {
+			t.Errorf("should have been equal, %+v, %+v", got, expected)
+		}
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		dj, _ := NewDojoClient("http://localhost", "token", nil)
+
+		_, err := dj.ImportScan.Create(context.Background(), &ImportScan{
+			ScanType: Str("Trivy Scan"),
+			File:     Str(filepath.Join(t.TempDir(), "missing.json")),
+		})
+		if cmp.Equal(err, nil) {
+			t.Errorf("expected an error with a missing file")
+		}
+	})
+}
@@ -0,0 +1,215 @@
+package defectdojo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// JiraFindingMappingsService manages the links between findings, finding groups
+// or engagements and the Jira issues they were pushed to.
+type JiraFindingMappingsService struct {
+	client *Client
+}
+
+type JiraIssues struct {
+	Count    *int         `json:"count,omitempty"`
+	Next     *string      `json:"next,omitempty"`
+	Previous *string      `json:"previous,omitempty"`
+	Results  *[]JiraIssue `json:"results,omitempty"`
+}
+
+type JiraFindingMappingsOptions struct {
+	Limit        int
+	Offset       int
+	ID           int
+	JiraId       string
+	JiraKey      string
+	Finding      int
+	Engagement   int
+	FindingGroup int
+}
+
+func (o *JiraFindingMappingsOptions) ToString() string {
+	if o == nil {
+		return ""
+	}
+
+	v := url.Values{}
+	if o.Limit > 0 {
+		v.Set("limit", strconv.Itoa(o.Limit))
+	}
+	if o.Offset > 0 {
+		v.Set("offset", strconv.Itoa(o.Offset))
+	}
+	if o.ID > 0 {
+		v.Set("id", strconv.Itoa(o.ID))
+	}
+	if len(o.JiraId) > 0 {
+		v.Set("jira_id", o.JiraId)
+	}
+	if len(o.JiraKey) > 0 {
+		v.Set("jira_key", o.JiraKey)
+	}
+	if o.Finding > 0 {
+		v.Set("finding", strconv.Itoa(o.Finding))
+	}
+	if o.Engagement > 0 {
+		v.Set("engagement", strconv.Itoa(o.Engagement))
+	}
+	if o.FindingGroup > 0 {
+		v.Set("finding_group", strconv.Itoa(o.FindingGroup))
+	}
+
+	return "?" + v.Encode()
+}
+
+func (c *JiraFindingMappingsService) List(ctx context.Context, options *JiraFindingMappingsOptions) (*JiraIssues, error) {
+	path := fmt.Sprintf("%s/jira_finding_mappings/%s", c.client.BaseURL, options.ToString())
+
+	req, err := http.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := JiraIssues{}
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// All returns an iterator over all Jira finding mappings matching the given options,
+// fetching further pages from DefectDojo as the iteration advances.
+func (c *JiraFindingMappingsService) All(ctx context.Context, options *JiraFindingMappingsOptions) iter.Seq2[JiraIssue, error] {
+	path := fmt.Sprintf("%s/jira_finding_mappings/%s", c.client.BaseURL, options.ToString())
+
+	return all[JiraIssue](ctx, c.client, path)
+}
+
+func (c *JiraFindingMappingsService) Read(ctx context.Context, id int) (*JiraIssue, error) {
+	path := fmt.Sprintf("%s/jira_finding_mappings/%d/", c.client.BaseURL, id)
+
+	req, err := http.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(JiraIssue)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *JiraFindingMappingsService) Create(ctx context.Context, u *JiraIssue) (*JiraIssue, error) {
+	path := fmt.Sprintf("%s/jira_finding_mappings/", c.client.BaseURL)
+
+	postJSON, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPost, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(JiraIssue)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *JiraFindingMappingsService) Update(ctx context.Context, id int, u *JiraIssue) (*JiraIssue, error) {
+	path := fmt.Sprintf("%s/jira_finding_mappings/%d/", c.client.BaseURL, id)
+
+	postJSON, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPut, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(JiraIssue)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *JiraFindingMappingsService) PartialUpdate(ctx context.Context, id int, u *JiraIssue) (*JiraIssue, error) {
+	path := fmt.Sprintf("%s/jira_finding_mappings/%d/", c.client.BaseURL, id)
+
+	postJSON, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPatch, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(JiraIssue)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *JiraFindingMappingsService) Delete(ctx context.Context, id int) (*JiraIssue, error) {
+	path := fmt.Sprintf("%s/jira_finding_mappings/%d/", c.client.BaseURL, id)
+
+	req, err := http.NewRequest(http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(JiraIssue)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// FindingKeys returns the Jira keys of the findings linked to Jira issues that match options,
+// indexed by finding ID. Mappings of finding groups and engagements are skipped.
+func (c *JiraFindingMappingsService) FindingKeys(ctx context.Context, options *JiraFindingMappingsOptions) (map[int]string, error) {
+	res := make(map[int]string)
+	for m, err := range c.All(ctx, options) {
+		if err != nil {
+			return nil, err
+		}
+		if m.Finding == nil || m.JiraKey == nil {
+			continue
+		}
+		res[*m.Finding] = *m.JiraKey
+	}
+
+	return res, nil
+}
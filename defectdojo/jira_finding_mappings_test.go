@@ -0,0 +1,321 @@
+package defectdojo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestJiraFindingMappingsService_List(t *testing.T) {
+	response := `{
+		"count": 1,
+		"next": null,
+		"previous": null,
+		"results": [
+			{
+				"id": 3,
+				"url": "https://jira.example.com/browse/SEC-42",
+				"jira_id": "10042",
+				"jira_key": "SEC-42",
+				"finding": 101
+			}
+		]
+	}`
+
+	expected := JiraIssues{
+		Count:    Int(1),
+		Next:     nil,
+		Previous: nil,
+		Results: &[]JiraIssue{
+			{
+				Id:      Int(3),
+				Url:     Str("https://jira.example.com/browse/SEC-42"),
+				JiraId:  Str("10042"),
+				JiraKey: Str("SEC-42"),
+				Finding: Int(101),
+			},
+		},
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected GET request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/jira_finding_mappings/") {
+			t.Errorf("Expected /jira_finding_mappings/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.JiraFindingMappings.List(context.Background(), &JiraFindingMappingsOptions{
+		Limit: 10,
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestJiraFindingMappingsService_Read(t *testing.T) {
+	response := `{
+		"id": 3,
+		"url": "https://jira.example.com/browse/SEC-42",
+		"jira_id": "10042",
+		"jira_key": "SEC-42",
+		"finding": 101
+	}`
+
+	expected := JiraIssue{
+		Id:      Int(3),
+		Url:     Str("https://jira.example.com/browse/SEC-42"),
+		JiraId:  Str("10042"),
+		JiraKey: Str("SEC-42"),
+		Finding: Int(101),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected GET request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/jira_finding_mappings/3/") {
+			t.Errorf("Expected /jira_finding_mappings/3/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.JiraFindingMappings.Read(context.Background(), 3)
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestJiraFindingMappingsService_Create(t *testing.T) {
+	response := `{
+		"id": 3,
+		"url": "https://jira.example.com/browse/SEC-42",
+		"jira_id": "10042",
+		"jira_key": "SEC-42",
+		"finding": 101
+	}`
+
+	expected := JiraIssue{
+		Id:      Int(3),
+		Url:     Str("https://jira.example.com/browse/SEC-42"),
+		JiraId:  Str("10042"),
+		JiraKey: Str("SEC-42"),
+		Finding: Int(101),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("Expected POST request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/jira_finding_mappings/") {
+			t.Errorf("Expected /jira_finding_mappings/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.JiraFindingMappings.Create(context.Background(), &JiraIssue{
+		JiraId:  Str("10042"),
+		JiraKey: Str("SEC-42"),
+		Finding: Int(101),
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestJiraFindingMappingsService_Update(t *testing.T) {
+	response := `{
+		"id": 3,
+		"url": "https://jira.example.com/browse/SEC-42",
+		"jira_id": "10042",
+		"jira_key": "SEC-42",
+		"finding": 101
+	}`
+
+	expected := JiraIssue{
+		Id:      Int(3),
+		Url:     Str("https://jira.example.com/browse/SEC-42"),
+		JiraId:  Str("10042"),
+		JiraKey: Str("SEC-42"),
+		Finding: Int(101),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Errorf("Expected PUT request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/jira_finding_mappings/3/") {
+			t.Errorf("Expected /jira_finding_mappings/3/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.JiraFindingMappings.Update(context.Background(), 3, &JiraIssue{
+		JiraId:  Str("10042"),
+		JiraKey: Str("SEC-42"),
+		Finding: Int(101),
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestJiraFindingMappingsService_PartialUpdate(t *testing.T) {
+	response := `{
+		"id": 3,
+		"url": "https://jira.example.com/browse/SEC-42",
+		"jira_id": "10042",
+		"jira_key": "SEC-42",
+		"finding": 101
+	}`
+
+	expected := JiraIssue{
+		Id:      Int(3),
+		Url:     Str("https://jira.example.com/browse/SEC-42"),
+		JiraId:  Str("10042"),
+		JiraKey: Str("SEC-42"),
+		Finding: Int(101),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch {
+			t.Errorf("Expected PATCH request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/jira_finding_mappings/3/") {
+			t.Errorf("Expected /jira_finding_mappings/3/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.JiraFindingMappings.PartialUpdate(context.Background(), 3, &JiraIssue{
+		JiraId:  Str("10042"),
+		JiraKey: Str("SEC-42"),
+		Finding: Int(101),
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestJiraFindingMappingsService_Delete(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("Expected DELETE request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/jira_finding_mappings/3/") {
+			t.Errorf("Expected /jira_finding_mappings/3/ in path, got %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	_, err := dj.JiraFindingMappings.Delete(context.Background(), 3)
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+}
+
+func TestJiraFindingMappingsOptions_ToString(t *testing.T) {
+	tests := []struct {
+		name     string
+		options  *JiraFindingMappingsOptions
+		expected string
+	}{
+		{
+			name: "finding only",
+			options: &JiraFindingMappingsOptions{
+				Finding: 101,
+			},
+			expected: "?finding=101",
+		},
+		{
+			name: "all fields",
+			options: &JiraFindingMappingsOptions{
+				Limit:      10,
+				JiraKey:    "SEC-42",
+				Finding:    101,
+				Engagement: 4,
+			},
+			expected: "?engagement=4&finding=101&jira_key=SEC-42&limit=10",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := tt.options.ToString()
+			if actual != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestJiraFindingMappingsService_FindingKeys(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("engagement") != "4" {
+			t.Errorf("Expected engagement=4 in query, got %s", r.URL.RawQuery)
+		}
+		_, _ = fmt.Fprintln(w, `{"count": 3, "next": null, "previous": null, "results": [
+			{"id": 1, "jira_key": "SEC-1", "finding": 101},
+			{"id": 2, "jira_key": "SEC-2", "finding": 102},
+			{"id": 3, "jira_key": "SEC-3", "finding_group": 9}
+		]}`)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.JiraFindingMappings.FindingKeys(context.Background(), &JiraFindingMappingsOptions{Engagement: 4})
+	if !cmp.Equal(err, nil) {
+		t.Fatalf("error: %s", err)
+	}
+
+	expected := map[int]string{101: "SEC-1", 102: "SEC-2"}
+	if !cmp.Equal(actual, expected) {
+		t.Errorf("should have been equal, %v, %v", actual, expected)
+	}
+}
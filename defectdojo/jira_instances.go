@@ -0,0 +1,203 @@
+package defectdojo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+type JiraInstancesService struct {
+	client *Client
+}
+
+type JiraInstance struct {
+	Id                             *int    `json:"id,omitempty"`
+	ConfigurationName              *string `json:"configuration_name,omitempty"`
+	Url                            *string `json:"url,omitempty"`
+	Username                       *string `json:"username,omitempty"`
+	Password                       *string `json:"password,omitempty"`
+	DefaultIssueType               *string `json:"default_issue_type,omitempty"`
+	IssueTemplateDir               *string `json:"issue_template_dir,omitempty"`
+	EpicNameId                     *int    `json:"epic_name_id,omitempty"`
+	OpenStatusKey                  *int    `json:"open_status_key,omitempty"`
+	CloseStatusKey                 *int    `json:"close_status_key,omitempty"`
+	InfoMappingSeverity            *string `json:"info_mapping_severity,omitempty"`
+	LowMappingSeverity             *string `json:"low_mapping_severity,omitempty"`
+	MediumMappingSeverity          *string `json:"medium_mapping_severity,omitempty"`
+	HighMappingSeverity            *string `json:"high_mapping_severity,omitempty"`
+	CriticalMappingSeverity        *string `json:"critical_mapping_severity,omitempty"`
+	FindingText                    *string `json:"finding_text,omitempty"`
+	AcceptedMappingResolution      *string `json:"accepted_mapping_resolution,omitempty"`
+	FalsePositiveMappingResolution *string `json:"false_positive_mapping_resolution,omitempty"`
+	GlobalJiraSlaNotification      *bool   `json:"global_jira_sla_notification,omitempty"`
+	FindingJiraSync                *bool   `json:"finding_jira_sync,omitempty"`
+}
+
+type JiraInstances struct {
+	Count    *int            `json:"count,omitempty"`
+	Next     *string         `json:"next,omitempty"`
+	Previous *string         `json:"previous,omitempty"`
+	Results  *[]JiraInstance `json:"results,omitempty"`
+}
+
+type JiraInstancesOptions struct {
+	Limit  int
+	Offset int
+	ID     int
+	Url    string
+}
+
+func (o *JiraInstancesOptions) ToString() string {
+	if o == nil {
+		return ""
+	}
+
+	v := url.Values{}
+	if o.Limit > 0 {
+		v.Set("limit", strconv.Itoa(o.Limit))
+	}
+	if o.Offset > 0 {
+		v.Set("offset", strconv.Itoa(o.Offset))
+	}
+	if o.ID > 0 {
+		v.Set("id", strconv.Itoa(o.ID))
+	}
+	if len(o.Url) > 0 {
+		v.Set("url", o.Url)
+	}
+
+	return "?" + v.Encode()
+}
+
+func (c *JiraInstancesService) List(ctx context.Context, options *JiraInstancesOptions) (*JiraInstances, error) {
+	path := fmt.Sprintf("%s/jira_instances/%s", c.client.BaseURL, options.ToString())
+
+	req, err := http.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := JiraInstances{}
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// All returns an iterator over all Jira instances matching the given options,
+// fetching further pages from DefectDojo as the iteration advances.
+func (c *JiraInstancesService) All(ctx context.Context, options *JiraInstancesOptions) iter.Seq2[JiraInstance, error] {
+	path := fmt.Sprintf("%s/jira_instances/%s", c.client.BaseURL, options.ToString())
+
+	return all[JiraInstance](ctx, c.client, path)
+}
+
+func (c *JiraInstancesService) Read(ctx context.Context, id int) (*JiraInstance, error) {
+	path := fmt.Sprintf("%s/jira_instances/%d/", c.client.BaseURL, id)
+
+	req, err := http.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(JiraInstance)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *JiraInstancesService) Create(ctx context.Context, u *JiraInstance) (*JiraInstance, error) {
+	path := fmt.Sprintf("%s/jira_instances/", c.client.BaseURL)
+
+	postJSON, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPost, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(JiraInstance)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *JiraInstancesService) Update(ctx context.Context, id int, u *JiraInstance) (*JiraInstance, error) {
+	path := fmt.Sprintf("%s/jira_instances/%d/", c.client.BaseURL, id)
+
+	postJSON, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPut, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(JiraInstance)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *JiraInstancesService) PartialUpdate(ctx context.Context, id int, u *JiraInstance) (*JiraInstance, error) {
+	path := fmt.Sprintf("%s/jira_instances/%d/", c.client.BaseURL, id)
+
+	postJSON, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPatch, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(JiraInstance)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *JiraInstancesService) Delete(ctx context.Context, id int) (*JiraInstance, error) {
+	path := fmt.Sprintf("%s/jira_instances/%d/", c.client.BaseURL, id)
+
+	req, err := http.NewRequest(http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(JiraInstance)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
@@ -0,0 +1,313 @@
+package defectdojo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestJiraInstancesService_List(t *testing.T) {
+	response := `{
+		"count": 1,
+		"next": null,
+		"previous": null,
+		"results": [
+			{
+				"id": 2,
+				"configuration_name": "Corporate Jira",
+				"url": "https://jira.example.com",
+				"username": "dojo",
+				"default_issue_type": "Bug",
+				"epic_name_id": 10011,
+				"open_status_key": 11,
+				"close_status_key": 21,
+				"high_mapping_severity": "High",
+				"finding_jira_sync": true
+			}
+		]
+	}`
+
+	expected := JiraInstances{
+		Count:    Int(1),
+		Next:     nil,
+		Previous: nil,
+		Results: &[]JiraInstance{
+			{
+				Id:                  Int(2),
+				ConfigurationName:   Str("Corporate Jira"),
+				Url:                 Str("https://jira.example.com"),
+				Username:            Str("dojo"),
+				DefaultIssueType:    Str("Bug"),
+				EpicNameId:          Int(10011),
+				OpenStatusKey:       Int(11),
+				CloseStatusKey:      Int(21),
+				HighMappingSeverity: Str("High"),
+				FindingJiraSync:     Bool(true),
+			},
+		},
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected GET request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/jira_instances/") {
+			t.Errorf("Expected /jira_instances/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.JiraInstances.List(context.Background(), &JiraInstancesOptions{
+		Limit: 10,
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestJiraInstancesService_Read(t *testing.T) {
+	response := `{
+		"id": 2,
+		"configuration_name": "Corporate Jira",
+		"url": "https://jira.example.com",
+		"username": "dojo",
+		"default_issue_type": "Bug",
+		"epic_name_id": 10011,
+		"open_status_key": 11,
+		"close_status_key": 21,
+		"high_mapping_severity": "High",
+		"finding_jira_sync": true
+	}`
+
+	expected := JiraInstance{
+		Id:                  Int(2),
+		ConfigurationName:   Str("Corporate Jira"),
+		Url:                 Str("https://jira.example.com"),
+		Username:            Str("dojo"),
+		DefaultIssueType:    Str("Bug"),
+		EpicNameId:          Int(10011),
+		OpenStatusKey:       Int(11),
+		CloseStatusKey:      Int(21),
+		HighMappingSeverity: Str("High"),
+		FindingJiraSync:     Bool(true),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected GET request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/jira_instances/2/") {
+			t.Errorf("Expected /jira_instances/2/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.JiraInstances.Read(context.Background(), 2)
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestJiraInstancesService_Create(t *testing.T) {
+	response := `{
+		"id": 2,
+		"configuration_name": "Corporate Jira",
+		"url": "https://jira.example.com",
+		"username": "dojo",
+		"default_issue_type": "Bug",
+		"epic_name_id": 10011,
+		"open_status_key": 11,
+		"close_status_key": 21,
+		"high_mapping_severity": "High",
+		"finding_jira_sync": true
+	}`
+
+	expected := JiraInstance{
+		Id:                  Int(2),
+		ConfigurationName:   Str("Corporate Jira"),
+		Url:                 Str("https://jira.example.com"),
+		Username:            Str("dojo"),
+		DefaultIssueType:    Str("Bug"),
+		EpicNameId:          Int(10011),
+		OpenStatusKey:       Int(11),
+		CloseStatusKey:      Int(21),
+		HighMappingSeverity: Str("High"),
+		FindingJiraSync:     Bool(true),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("Expected POST request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/jira_instances/") {
+			t.Errorf("Expected /jira_instances/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.JiraInstances.Create(context.Background(), &JiraInstance{
+		ConfigurationName: Str("Corporate Jira"),
+		Url:               Str("https://jira.example.com"),
+		Username:          Str("dojo"),
+		Password:          Str("secret"),
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestJiraInstancesService_Update(t *testing.T) {
+	response := `{
+		"id": 2,
+		"configuration_name": "Corporate Jira",
+		"url": "https://jira.example.com",
+		"username": "dojo",
+		"default_issue_type": "Bug",
+		"epic_name_id": 10011,
+		"open_status_key": 11,
+		"close_status_key": 21,
+		"high_mapping_severity": "High",
+		"finding_jira_sync": true
+	}`
+
+	expected := JiraInstance{
+		Id:                  Int(2),
+		ConfigurationName:   Str("Corporate Jira"),
+		Url:                 Str("https://jira.example.com"),
+		Username:            Str("dojo"),
+		DefaultIssueType:    Str("Bug"),
+		EpicNameId:          Int(10011),
+		OpenStatusKey:       Int(11),
+		CloseStatusKey:      Int(21),
+		HighMappingSeverity: Str("High"),
+		FindingJiraSync:     Bool(true),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Errorf("Expected PUT request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/jira_instances/2/") {
+			t.Errorf("Expected /jira_instances/2/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.JiraInstances.Update(context.Background(), 2, &JiraInstance{
+		ConfigurationName: Str("Corporate Jira"),
+		Url:               Str("https://jira.example.com"),
+		Username:          Str("dojo"),
+		Password:          Str("secret"),
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestJiraInstancesService_PartialUpdate(t *testing.T) {
+	response := `{
+		"id": 2,
+		"configuration_name": "Corporate Jira",
+		"url": "https://jira.example.com",
+		"username": "dojo",
+		"default_issue_type": "Bug",
+		"epic_name_id": 10011,
+		"open_status_key": 11,
+		"close_status_key": 21,
+		"high_mapping_severity": "High",
+		"finding_jira_sync": true
+	}`
+
+	expected := JiraInstance{
+		Id:                  Int(2),
+		ConfigurationName:   Str("Corporate Jira"),
+		Url:                 Str("https://jira.example.com"),
+		Username:            Str("dojo"),
+		DefaultIssueType:    Str("Bug"),
+		EpicNameId:          Int(10011),
+		OpenStatusKey:       Int(11),
+		CloseStatusKey:      Int(21),
+		HighMappingSeverity: Str("High"),
+		FindingJiraSync:     Bool(true),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch {
+			t.Errorf("Expected PATCH request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/jira_instances/2/") {
+			t.Errorf("Expected /jira_instances/2/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.JiraInstances.PartialUpdate(context.Background(), 2, &JiraInstance{
+		ConfigurationName: Str("Corporate Jira"),
+		Url:               Str("https://jira.example.com"),
+		Username:          Str("dojo"),
+		Password:          Str("secret"),
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestJiraInstancesService_Delete(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("Expected DELETE request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/jira_instances/2/") {
+			t.Errorf("Expected /jira_instances/2/ in path, got %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	_, err := dj.JiraInstances.Delete(context.Background(), 2)
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+}
@@ -0,0 +1,144 @@
+package defectdojo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+)
+
+// JiraProductConfigurationsService manages the Jira project mappings of products.
+// DefectDojo represents them as JiraProject objects, like JiraProjectsService.
+type JiraProductConfigurationsService struct {
+	client *Client
+}
+
+func (c *JiraProductConfigurationsService) List(ctx context.Context, options *JiraProjectsOptions) (*JiraProjects, error) {
+	path := fmt.Sprintf("%s/jira_product_configurations/%s", c.client.BaseURL, options.ToString())
+
+	req, err := http.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := JiraProjects{}
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// All returns an iterator over all Jira product configurations matching the given options,
+// fetching further pages from DefectDojo as the iteration advances.
+func (c *JiraProductConfigurationsService) All(ctx context.Context, options *JiraProjectsOptions) iter.Seq2[JiraProject, error] {
+	path := fmt.Sprintf("%s/jira_product_configurations/%s", c.client.BaseURL, options.ToString())
+
+	return all[JiraProject](ctx, c.client, path)
+}
+
+func (c *JiraProductConfigurationsService) Read(ctx context.Context, id int) (*JiraProject, error) {
+	path := fmt.Sprintf("%s/jira_product_configurations/%d/", c.client.BaseURL, id)
+
+	req, err := http.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(JiraProject)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *JiraProductConfigurationsService) Create(ctx context.Context, u *JiraProject) (*JiraProject, error) {
+	path := fmt.Sprintf("%s/jira_product_configurations/", c.client.BaseURL)
+
+	postJSON, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPost, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(JiraProject)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *JiraProductConfigurationsService) Update(ctx context.Context, id int, u *JiraProject) (*JiraProject, error) {
+	path := fmt.Sprintf("%s/jira_product_configurations/%d/", c.client.BaseURL, id)
+
+	postJSON, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPut, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(JiraProject)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *JiraProductConfigurationsService) PartialUpdate(ctx context.Context, id int, u *JiraProject) (*JiraProject, error) {
+	path := fmt.Sprintf("%s/jira_product_configurations/%d/", c.client.BaseURL, id)
+
+	postJSON, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPatch, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(JiraProject)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *JiraProductConfigurationsService) Delete(ctx context.Context, id int) (*JiraProject, error) {
+	path := fmt.Sprintf("%s/jira_product_configurations/%d/", c.client.BaseURL, id)
+
+	req, err := http.NewRequest(http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(JiraProject)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
@@ -0,0 +1,220 @@
+package defectdojo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestJiraProductConfigurationsService_List(t *testing.T) {
+	response := `{
+		"count": 1,
+		"next": null,
+		"previous": null,
+		"results": [
+			{
+				"id": 5,
+				"project_key": "SEC",
+				"component": "backend",
+				"jira_labels": "security dojo",
+				"push_all_issues": false,
+				"push_notes": true,
+				"jira_instance": 2,
+				"product": 7
+			}
+		]
+	}`
+
+	expected := JiraProjects{
+		Count:    Int(1),
+		Next:     nil,
+		Previous: nil,
+		Results: &[]JiraProject{
+			{
+				Id:            Int(5),
+				ProjectKey:    Str("SEC"),
+				Component:     Str("backend"),
+				JiraLabels:    Str("security dojo"),
+				PushAllIssues: Bool(false),
+				PushNotes:     Bool(true),
+				JiraInstance:  Int(2),
+				Product:       Int(7),
+			},
+		},
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected GET request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/jira_product_configurations/") {
+			t.Errorf("Expected /jira_product_configurations/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.JiraProductConfigurations.List(context.Background(), &JiraProjectsOptions{
+		Limit: 10,
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestJiraProductConfigurationsService_Read(t *testing.T) {
+	response := `{
+		"id": 5,
+		"project_key": "SEC",
+		"component": "backend",
+		"jira_labels": "security dojo",
+		"push_all_issues": false,
+		"push_notes": true,
+		"jira_instance": 2,
+		"product": 7
+	}`
+
+	expected := JiraProject{
+		Id:            Int(5),
+		ProjectKey:    Str("SEC"),
+		Component:     Str("backend"),
+		JiraLabels:    Str("security dojo"),
+		PushAllIssues: Bool(false),
+		PushNotes:     Bool(true),
+		JiraInstance:  Int(2),
+		Product:       Int(7),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected GET request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/jira_product_configurations/5/") {
+			t.Errorf("Expected /jira_product_configurations/5/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.JiraProductConfigurations.Read(context.Background(), 5)
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestJiraProductConfigurationsService_Create(t *testing.T) {
+	response := `{
+		"id": 5,
+		"project_key": "SEC",
+		"component": "backend",
+		"jira_labels": "security dojo",
+		"push_all_issues": false,
+		"push_notes": true,
+		"jira_instance": 2,
+		"product": 7
+	}`
+
+	expected := JiraProject{
+		Id:            Int(5),
+		ProjectKey:    Str("SEC"),
+		Component:     Str("backend"),
+		JiraLabels:    Str("security dojo"),
+		PushAllIssues: Bool(false),
+		PushNotes:     Bool(true),
+		JiraInstance:  Int(2),
+		Product:       Int(7),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("Expected POST request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/jira_product_configurations/") {
+			t.Errorf("Expected /jira_product_configurations/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.JiraProductConfigurations.Create(context.Background(), &JiraProject{
+		ProjectKey:   Str("SEC"),
+		JiraInstance: Int(2),
+		Product:      Int(7),
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestJiraProductConfigurationsService_PartialUpdate(t *testing.T) {
+	response := `{
+		"id": 5,
+		"project_key": "SEC",
+		"component": "backend",
+		"jira_labels": "security dojo",
+		"push_all_issues": false,
+		"push_notes": true,
+		"jira_instance": 2,
+		"product": 7
+	}`
+
+	expected := JiraProject{
+		Id:            Int(5),
+		ProjectKey:    Str("SEC"),
+		Component:     Str("backend"),
+		JiraLabels:    Str("security dojo"),
+		PushAllIssues: Bool(false),
+		PushNotes:     Bool(true),
+		JiraInstance:  Int(2),
+		Product:       Int(7),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch {
+			t.Errorf("Expected PATCH request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/jira_product_configurations/5/") {
+			t.Errorf("Expected /jira_product_configurations/5/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.JiraProductConfigurations.PartialUpdate(context.Background(), 5, &JiraProject{
+		ProjectKey:   Str("SEC"),
+		JiraInstance: Int(2),
+		Product:      Int(7),
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
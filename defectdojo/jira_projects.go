@@ -0,0 +1,228 @@
+package defectdojo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+type JiraProjectsService struct {
+	client *Client
+}
+
+// JiraProject maps a product or engagement to a project of a Jira instance.
+type JiraProject struct {
+	Id                                   *int                    `json:"id,omitempty"`
+	ProjectKey                           *string                 `json:"project_key,omitempty"`
+	IssueTemplateDir                     *string                 `json:"issue_template_dir,omitempty"`
+	Component                            *string                 `json:"component,omitempty"`
+	CustomFields                         *map[string]interface{} `json:"custom_fields,omitempty"`
+	JiraLabels                           *string                 `json:"jira_labels,omitempty"`
+	DefaultAssignee                      *string                 `json:"default_assignee,omitempty"`
+	AddVulnerabilityIdToJiraLabel        *bool                   `json:"add_vulnerability_id_to_jira_label,omitempty"`
+	PushAllIssues                        *bool                   `json:"push_all_issues,omitempty"`
+	EnableEngagementEpicMapping          *bool                   `json:"enable_engagement_epic_mapping,omitempty"`
+	PushNotes                            *bool                   `json:"push_notes,omitempty"`
+	ProductJiraSlaNotification           *bool                   `json:"product_jira_sla_notification,omitempty"`
+	RiskAcceptanceExpirationNotification *bool                   `json:"risk_acceptance_expiration_notification,omitempty"`
+	JiraInstance                         *int                    `json:"jira_instance,omitempty"`
+	Product                              *int                    `json:"product,omitempty"`
+	Engagement                           *int                    `json:"engagement,omitempty"`
+}
+
+type JiraProjects struct {
+	Count    *int           `json:"count,omitempty"`
+	Next     *string        `json:"next,omitempty"`
+	Previous *string        `json:"previous,omitempty"`
+	Results  *[]JiraProject `json:"results,omitempty"`
+}
+
+type JiraProjectsOptions struct {
+	Limit                       int
+	Offset                      int
+	ID                          int
+	JiraInstance                int
+	Product                     int
+	Engagement                  int
+	Component                   string
+	ProjectKey                  string
+	PushAllIssues               string
+	EnableEngagementEpicMapping string
+	PushNotes                   string
+}
+
+func (o *JiraProjectsOptions) ToString() string {
+	if o == nil {
+		return ""
+	}
+
+	v := url.Values{}
+	if o.Limit > 0 {
+		v.Set("limit", strconv.Itoa(o.Limit))
+	}
+	if o.Offset > 0 {
+		v.Set("offset", strconv.Itoa(o.Offset))
+	}
+	if o.ID > 0 {
+		v.Set("id", strconv.Itoa(o.ID))
+	}
+	if o.JiraInstance > 0 {
+		v.Set("jira_instance", strconv.Itoa(o.JiraInstance))
+	}
+	if o.Product > 0 {
+		v.Set("product", strconv.Itoa(o.Product))
+	}
+	if o.Engagement > 0 {
+		v.Set("engagement", strconv.Itoa(o.Engagement))
+	}
+	if len(o.Component) > 0 {
+		v.Set("component", o.Component)
+	}
+	if len(o.ProjectKey) > 0 {
+		v.Set("project_key", o.ProjectKey)
+	}
+	if len(o.PushAllIssues) > 0 {
+		v.Set("push_all_issues", o.PushAllIssues)
+	}
+	if len(o.EnableEngagementEpicMapping) > 0 {
+		v.Set("enable_engagement_epic_mapping", o.EnableEngagementEpicMapping)
+	}
+	if len(o.PushNotes) > 0 {
+		v.Set("push_notes", o.PushNotes)
+	}
+
+	return "?" + v.Encode()
+}
+
+func (c *JiraProjectsService) List(ctx context.Context, options *JiraProjectsOptions) (*JiraProjects, error) {
+	path := fmt.Sprintf("%s/jira_projects/%s", c.client.BaseURL, options.ToString())
+
+	req, err := http.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := JiraProjects{}
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// All returns an iterator over all Jira projects matching the given options,
+// fetching further pages from DefectDojo as the iteration advances.
+func (c *JiraProjectsService) All(ctx context.Context, options *JiraProjectsOptions) iter.Seq2[JiraProject, error] {
+	path := fmt.Sprintf("%s/jira_projects/%s", c.client.BaseURL, options.ToString())
+
+	return all[JiraProject](ctx, c.client, path)
+}
+
+func (c *JiraProjectsService) Read(ctx context.Context, id int) (*JiraProject, error) {
+	path := fmt.Sprintf("%s/jira_projects/%d/", c.client.BaseURL, id)
+
+	req, err := http.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(JiraProject)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *JiraProjectsService) Create(ctx context.Context, u *JiraProject) (*JiraProject, error) {
+	path := fmt.Sprintf("%s/jira_projects/", c.client.BaseURL)
+
+	postJSON, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPost, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(JiraProject)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *JiraProjectsService) Update(ctx context.Context, id int, u *JiraProject) (*JiraProject, error) {
+	path := fmt.Sprintf("%s/jira_projects/%d/", c.client.BaseURL, id)
+
+	postJSON, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPut, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(JiraProject)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *JiraProjectsService) PartialUpdate(ctx context.Context, id int, u *JiraProject) (*JiraProject, error) {
+	path := fmt.Sprintf("%s/jira_projects/%d/", c.client.BaseURL, id)
+
+	postJSON, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPatch, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(JiraProject)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *JiraProjectsService) Delete(ctx context.Context, id int) (*JiraProject, error) {
+	path := fmt.Sprintf("%s/jira_projects/%d/", c.client.BaseURL, id)
+
+	req, err := http.NewRequest(http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(JiraProject)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
@@ -0,0 +1,326 @@
+package defectdojo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestJiraProjectsService_List(t *testing.T) {
+	response := `{
+		"count": 1,
+		"next": null,
+		"previous": null,
+		"results": [
+			{
+				"id": 5,
+				"project_key": "SEC",
+				"component": "backend",
+				"jira_labels": "security dojo",
+				"push_all_issues": false,
+				"push_notes": true,
+				"jira_instance": 2,
+				"product": 7
+			}
+		]
+	}`
+
+	expected := JiraProjects{
+		Count:    Int(1),
+		Next:     nil,
+		Previous: nil,
+		Results: &[]JiraProject{
+			{
+				Id:            Int(5),
+				ProjectKey:    Str("SEC"),
+				Component:     Str("backend"),
+				JiraLabels:    Str("security dojo"),
+				PushAllIssues: Bool(false),
+				PushNotes:     Bool(true),
+				JiraInstance:  Int(2),
+				Product:       Int(7),
+			},
+		},
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected GET request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/jira_projects/") {
+			t.Errorf("Expected /jira_projects/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.JiraProjects.List(context.Background(), &JiraProjectsOptions{
+		Limit: 10,
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestJiraProjectsService_Read(t *testing.T) {
+	response := `{
+		"id": 5,
+		"project_key": "SEC",
+		"component": "backend",
+		"jira_labels": "security dojo",
+		"push_all_issues": false,
+		"push_notes": true,
+		"jira_instance": 2,
+		"product": 7
+	}`
+
+	expected := JiraProject{
+		Id:            Int(5),
+		ProjectKey:    Str("SEC"),
+		Component:     Str("backend"),
+		JiraLabels:    Str("security dojo"),
+		PushAllIssues: Bool(false),
+		PushNotes:     Bool(true),
+		JiraInstance:  Int(2),
+		Product:       Int(7),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected GET request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/jira_projects/5/") {
+			t.Errorf("Expected /jira_projects/5/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.JiraProjects.Read(context.Background(), 5)
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestJiraProjectsService_Create(t *testing.T) {
+	response := `{
+		"id": 5,
+		"project_key": "SEC",
+		"component": "backend",
+		"jira_labels": "security dojo",
+		"push_all_issues": false,
+		"push_notes": true,
+		"jira_instance": 2,
+		"product": 7
+	}`
+
+	expected := JiraProject{
+		Id:            Int(5),
+		ProjectKey:    Str("SEC"),
+		Component:     Str("backend"),
+		JiraLabels:    Str("security dojo"),
+		PushAllIssues: Bool(false),
+		PushNotes:     Bool(true),
+		JiraInstance:  Int(2),
+		Product:       Int(7),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("Expected POST request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/jira_projects/") {
+			t.Errorf("Expected /jira_projects/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.JiraProjects.Create(context.Background(), &JiraProject{
+		ProjectKey:   Str("SEC"),
+		JiraInstance: Int(2),
+		Product:      Int(7),
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestJiraProjectsService_Update(t *testing.T) {
+	response := `{
+		"id": 5,
+		"project_key": "SEC",
+		"component": "backend",
+		"jira_labels": "security dojo",
+		"push_all_issues": false,
+		"push_notes": true,
+		"jira_instance": 2,
+		"product": 7
+	}`
+
+	expected := JiraProject{
+		Id:            Int(5),
+		ProjectKey:    Str("SEC"),
+		Component:     Str("backend"),
+		JiraLabels:    Str("security dojo"),
+		PushAllIssues: Bool(false),
+		PushNotes:     Bool(true),
+		JiraInstance:  Int(2),
+		Product:       Int(7),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Errorf("Expected PUT request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/jira_projects/5/") {
+			t.Errorf("Expected /jira_projects/5/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.JiraProjects.Update(context.Background(), 5, &JiraProject{
+		ProjectKey:   Str("SEC"),
+		JiraInstance: Int(2),
+		Product:      Int(7),
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestJiraProjectsService_PartialUpdate(t *testing.T) {
+	response := `{
+		"id": 5,
+		"project_key": "SEC",
+		"component": "backend",
+		"jira_labels": "security dojo",
+		"push_all_issues": false,
+		"push_notes": true,
+		"jira_instance": 2,
+		"product": 7
+	}`
+
+	expected := JiraProject{
+		Id:            Int(5),
+		ProjectKey:    Str("SEC"),
+		Component:     Str("backend"),
+		JiraLabels:    Str("security dojo"),
+		PushAllIssues: Bool(false),
+		PushNotes:     Bool(true),
+		JiraInstance:  Int(2),
+		Product:       Int(7),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch {
+			t.Errorf("Expected PATCH request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/jira_projects/5/") {
+			t.Errorf("Expected /jira_projects/5/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.JiraProjects.PartialUpdate(context.Background(), 5, &JiraProject{
+		ProjectKey:   Str("SEC"),
+		JiraInstance: Int(2),
+		Product:      Int(7),
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestJiraProjectsService_Delete(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("Expected DELETE request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/jira_projects/5/") {
+			t.Errorf("Expected /jira_projects/5/ in path, got %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	_, err := dj.JiraProjects.Delete(context.Background(), 5)
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+}
+
+func TestJiraProjectsOptions_ToString(t *testing.T) {
+	tests := []struct {
+		name     string
+		options  *JiraProjectsOptions
+		expected string
+	}{
+		{
+			name: "product only",
+			options: &JiraProjectsOptions{
+				Product: 7,
+			},
+			expected: "?product=7",
+		},
+		{
+			name: "all fields",
+			options: &JiraProjectsOptions{
+				Limit:         10,
+				JiraInstance:  2,
+				Product:       7,
+				ProjectKey:    "SEC",
+				PushAllIssues: "true",
+			},
+			expected: "?jira_instance=2&limit=10&product=7&project_key=SEC&push_all_issues=true",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := tt.options.ToString()
+			if actual != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,190 @@
+package defectdojo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+type LanguageTypesService struct {
+	client *Client
+}
+
+type LanguageType struct {
+	Id       *int    `json:"id,omitempty"`
+	Language *string `json:"language,omitempty"`
+	Color    *string `json:"color,omitempty"`
+}
+
+type LanguageTypes struct {
+	Count    *int            `json:"count,omitempty"`
+	Next     *string         `json:"next,omitempty"`
+	Previous *string         `json:"previous,omitempty"`
+	Results  *[]LanguageType `json:"results,omitempty"`
+}
+
+type LanguageTypesOptions struct {
+	Limit    int
+	Offset   int
+	ID       int
+	Language string
+	Color    string
+}
+
+func (o *LanguageTypesOptions) ToString() string {
+	if o == nil {
+		return ""
+	}
+
+	v := url.Values{}
+	if o.Limit > 0 {
+		v.Set("limit", strconv.Itoa(o.Limit))
+	}
+	if o.Offset > 0 {
+		v.Set("offset", strconv.Itoa(o.Offset))
+	}
+	if o.ID > 0 {
+		v.Set("id", strconv.Itoa(o.ID))
+	}
+	if len(o.Language) > 0 {
+		v.Set("language", o.Language)
+	}
+	if len(o.Color) > 0 {
+		v.Set("color", o.Color)
+	}
+
+	return "?" + v.Encode()
+}
+
+func (c *LanguageTypesService) List(ctx context.Context, options *LanguageTypesOptions) (*LanguageTypes, error) {
+	path := fmt.Sprintf("%s/language_types/%s", c.client.BaseURL, options.ToString())
+
+	req, err := http.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := LanguageTypes{}
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// All returns an iterator over all language types matching the given options,
+// fetching further pages from DefectDojo as the iteration advances.
+func (c *LanguageTypesService) All(ctx context.Context, options *LanguageTypesOptions) iter.Seq2[LanguageType, error] {
+	path := fmt.Sprintf("%s/language_types/%s", c.client.BaseURL, options.ToString())
+
+	return all[LanguageType](ctx, c.client, path)
+}
+
+func (c *LanguageTypesService) Read(ctx context.Context, id int) (*LanguageType, error) {
+	path := fmt.Sprintf("%s/language_types/%d/", c.client.BaseURL, id)
+
+	req, err := http.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(LanguageType)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *LanguageTypesService) Create(ctx context.Context, u *LanguageType) (*LanguageType, error) {
+	path := fmt.Sprintf("%s/language_types/", c.client.BaseURL)
+
+	postJSON, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPost, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(LanguageType)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *LanguageTypesService) Update(ctx context.Context, id int, u *LanguageType) (*LanguageType, error) {
+	path := fmt.Sprintf("%s/language_types/%d/", c.client.BaseURL, id)
+
+	postJSON, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPut, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(LanguageType)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *LanguageTypesService) PartialUpdate(ctx context.Context, id int, u *LanguageType) (*LanguageType, error) {
+	path := fmt.Sprintf("%s/language_types/%d/", c.client.BaseURL, id)
+
+	postJSON, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPatch, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(LanguageType)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *LanguageTypesService) Delete(ctx context.Context, id int) (*LanguageType, error) {
+	path := fmt.Sprintf("%s/language_types/%d/", c.client.BaseURL, id)
+
+	req, err := http.NewRequest(http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(LanguageType)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
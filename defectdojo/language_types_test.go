@@ -0,0 +1,262 @@
+package defectdojo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestLanguageTypesService_List(t *testing.T) {
+	response := `{
+		"count": 1,
+		"next": null,
+		"previous": null,
+		"results": [
+			{
+				"id": 4,
+				"language": "Go",
+				"color": "#00ADD8"
+			}
+		]
+	}`
+
+	expected := LanguageTypes{
+		Count:    Int(1),
+		Next:     nil,
+		Previous: nil,
+		Results: &[]LanguageType{
+			{
+				Id:       Int(4),
+				Language: Str("Go"),
+				Color:    Str("#00ADD8"),
+			},
+		},
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected GET request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/language_types/") {
+			t.Errorf("Expected /language_types/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.LanguageTypes.List(context.Background(), &LanguageTypesOptions{
+		Limit: 10,
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestLanguageTypesService_Read(t *testing.T) {
+	response := `{
+		"id": 4,
+		"language": "Go",
+		"color": "#00ADD8"
+	}`
+
+	expected := LanguageType{
+		Id:       Int(4),
+		Language: Str("Go"),
+		Color:    Str("#00ADD8"),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected GET request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/language_types/4/") {
+			t.Errorf("Expected /language_types/4/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.LanguageTypes.Read(context.Background(), 4)
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestLanguageTypesService_Create(t *testing.T) {
+	response := `{
+		"id": 4,
+		"language": "Go",
+		"color": "#00ADD8"
+	}`
+
+	expected := LanguageType{
+		Id:       Int(4),
+		Language: Str("Go"),
+		Color:    Str("#00ADD8"),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("Expected POST request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/language_types/") {
+			t.Errorf("Expected /language_types/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.LanguageTypes.Create(context.Background(), &LanguageType{
+		Language: Str("Go"),
+		Color:    Str("#00ADD8"),
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestLanguageTypesService_Update(t *testing.T) {
+	response := `{
+		"id": 4,
+		"language": "Go",
+		"color": "#00ADD8"
+	}`
+
+	expected := LanguageType{
+		Id:       Int(4),
+		Language: Str("Go"),
+		Color:    Str("#00ADD8"),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Errorf("Expected PUT request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/language_types/4/") {
+			t.Errorf("Expected /language_types/4/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.LanguageTypes.Update(context.Background(), 4, &LanguageType{
+		Language: Str("Go"),
+		Color:    Str("#00ADD8"),
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestLanguageTypesService_PartialUpdate(t *testing.T) {
+	response := `{
+		"id": 4,
+		"language": "Go",
+		"color": "#00ADD8"
+	}`
+
+	expected := LanguageType{
+		Id:       Int(4),
+		Language: Str("Go"),
+		Color:    Str("#00ADD8"),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch {
+			t.Errorf("Expected PATCH request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/language_types/4/") {
+			t.Errorf("Expected /language_types/4/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.LanguageTypes.PartialUpdate(context.Background(), 4, &LanguageType{
+		Language: Str("Go"),
+		Color:    Str("#00ADD8"),
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestLanguageTypesService_Delete(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("Expected DELETE request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/language_types/4/") {
+			t.Errorf("Expected /language_types/4/ in path, got %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	_, err := dj.LanguageTypes.Delete(context.Background(), 4)
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+}
+
+func TestLanguageTypesOptions_ToString(t *testing.T) {
+	tests := []struct {
+		name     string
+		options  *LanguageTypesOptions
+		expected string
+	}{
+		{
+			name: "language only",
+			options: &LanguageTypesOptions{
+				Language: "Go",
+			},
+			expected: "?language=Go",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := tt.options.ToString()
+			if actual != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,198 @@
+package defectdojo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+type LanguagesService struct {
+	client *Client
+}
+
+// Language holds the line counts of one language type in a product.
+type Language struct {
+	Id       *int       `json:"id,omitempty"`
+	Language *int       `json:"language,omitempty"`
+	Product  *int       `json:"product,omitempty"`
+	User     *int       `json:"user,omitempty"`
+	Files    *int       `json:"files,omitempty"`
+	Blank    *int       `json:"blank,omitempty"`
+	Comment  *int       `json:"comment,omitempty"`
+	Code     *int       `json:"code,omitempty"`
+	Created  *time.Time `json:"created,omitempty"`
+}
+
+type Languages struct {
+	Count    *int        `json:"count,omitempty"`
+	Next     *string     `json:"next,omitempty"`
+	Previous *string     `json:"previous,omitempty"`
+	Results  *[]Language `json:"results,omitempty"`
+}
+
+type LanguagesOptions struct {
+	Limit    int
+	Offset   int
+	ID       int
+	Language int
+	Product  int
+}
+
+func (o *LanguagesOptions) ToString() string {
+	if o == nil {
+		return ""
+	}
+
+	v := url.Values{}
+	if o.Limit > 0 {
+		v.Set("limit", strconv.Itoa(o.Limit))
+	}
+	if o.Offset > 0 {
+		v.Set("offset", strconv.Itoa(o.Offset))
+	}
+	if o.ID > 0 {
+		v.Set("id", strconv.Itoa(o.ID))
+	}
+	if o.Language > 0 {
+		v.Set("language", strconv.Itoa(o.Language))
+	}
+	if o.Product > 0 {
+		v.Set("product", strconv.Itoa(o.Product))
+	}
+
+	return "?" + v.Encode()
+}
+
+func (c *LanguagesService) List(ctx context.Context, options *LanguagesOptions) (*Languages, error) {
+	path := fmt.Sprintf("%s/languages/%s", c.client.BaseURL, options.ToString())
+
+	req, err := http.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := Languages{}
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// All returns an iterator over all languages matching the given options,
+// fetching further pages from DefectDojo as the iteration advances.
+func (c *LanguagesService) All(ctx context.Context, options *LanguagesOptions) iter.Seq2[Language, error] {
+	path := fmt.Sprintf("%s/languages/%s", c.client.BaseURL, options.ToString())
+
+	return all[Language](ctx, c.client, path)
+}
+
+func (c *LanguagesService) Read(ctx context.Context, id int) (*Language, error) {
+	path := fmt.Sprintf("%s/languages/%d/", c.client.BaseURL, id)
+
+	req, err := http.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(Language)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *LanguagesService) Create(ctx context.Context, u *Language) (*Language, error) {
+	path := fmt.Sprintf("%s/languages/", c.client.BaseURL)
+
+	postJSON, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPost, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(Language)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *LanguagesService) Update(ctx context.Context, id int, u *Language) (*Language, error) {
+	path := fmt.Sprintf("%s/languages/%d/", c.client.BaseURL, id)
+
+	postJSON, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPut, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(Language)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *LanguagesService) PartialUpdate(ctx context.Context, id int, u *Language) (*Language, error) {
+	path := fmt.Sprintf("%s/languages/%d/", c.client.BaseURL, id)
+
+	postJSON, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPatch, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(Language)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *LanguagesService) Delete(ctx context.Context, id int) (*Language, error) {
+	path := fmt.Sprintf("%s/languages/%d/", c.client.BaseURL, id)
+
+	req, err := http.NewRequest(http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(Language)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
@@ -0,0 +1,327 @@
+package defectdojo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestLanguagesService_List(t *testing.T) {
+	response := `{
+		"count": 1,
+		"next": null,
+		"previous": null,
+		"results": [
+			{
+				"id": 12,
+				"language": 4,
+				"product": 3,
+				"user": 1,
+				"files": 120,
+				"blank": 1500,
+				"comment": 900,
+				"code": 15000
+			}
+		]
+	}`
+
+	expected := Languages{
+		Count:    Int(1),
+		Next:     nil,
+		Previous: nil,
+		Results: &[]Language{
+			{
+				Id:       Int(12),
+				Language: Int(4),
+				Product:  Int(3),
+				User:     Int(1),
+				Files:    Int(120),
+				Blank:    Int(1500),
+				Comment:  Int(900),
+				Code:     Int(15000),
+			},
+		},
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected GET request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/languages/") {
+			t.Errorf("Expected /languages/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.Languages.List(context.Background(), &LanguagesOptions{
+		Limit: 10,
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestLanguagesService_Read(t *testing.T) {
+	response := `{
+		"id": 12,
+		"language": 4,
+		"product": 3,
+		"user": 1,
+		"files": 120,
+		"blank": 1500,
+		"comment": 900,
+		"code": 15000
+	}`
+
+	expected := Language{
+		Id:       Int(12),
+		Language: Int(4),
+		Product:  Int(3),
+		User:     Int(1),
+		Files:    Int(120),
+		Blank:    Int(1500),
+		Comment:  Int(900),
+		Code:     Int(15000),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected GET request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/languages/12/") {
+			t.Errorf("Expected /languages/12/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.Languages.Read(context.Background(), 12)
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestLanguagesService_Create(t *testing.T) {
+	response := `{
+		"id": 12,
+		"language": 4,
+		"product": 3,
+		"user": 1,
+		"files": 120,
+		"blank": 1500,
+		"comment": 900,
+		"code": 15000
+	}`
+
+	expected := Language{
+		Id:       Int(12),
+		Language: Int(4),
+		Product:  Int(3),
+		User:     Int(1),
+		Files:    Int(120),
+		Blank:    Int(1500),
+		Comment:  Int(900),
+		Code:     Int(15000),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("Expected POST request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/languages/") {
+			t.Errorf("Expected /languages/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.Languages.Create(context.Background(), &Language{
+		Language: Int(4),
+		Product:  Int(3),
+		Files:    Int(120),
+		Code:     Int(15000),
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestLanguagesService_Update(t *testing.T) {
+	response := `{
+		"id": 12,
+		"language": 4,
+		"product": 3,
+		"user": 1,
+		"files": 120,
+		"blank": 1500,
+		"comment": 900,
+		"code": 15000
+	}`
+
+	expected := Language{
+		Id:       Int(12),
+		Language: Int(4),
+		Product:  Int(3),
+		User:     Int(1),
+		Files:    Int(120),
+		Blank:    Int(1500),
+		Comment:  Int(900),
+		Code:     Int(15000),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Errorf("Expected PUT request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/languages/12/") {
+			t.Errorf("Expected /languages/12/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.Languages.Update(context.Background(), 12, &Language{
+		Language: Int(4),
+		Product:  Int(3),
+		Files:    Int(120),
+		Code:     Int(15000),
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestLanguagesService_PartialUpdate(t *testing.T) {
+	response := `{
+		"id": 12,
+		"language": 4,
+		"product": 3,
+		"user": 1,
+		"files": 120,
+		"blank": 1500,
+		"comment": 900,
+		"code": 15000
+	}`
+
+	expected := Language{
+		Id:       Int(12),
+		Language: Int(4),
+		Product:  Int(3),
+		User:     Int(1),
+		Files:    Int(120),
+		Blank:    Int(1500),
+		Comment:  Int(900),
+		Code:     Int(15000),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch {
+			t.Errorf("Expected PATCH request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/languages/12/") {
+			t.Errorf("Expected /languages/12/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.Languages.PartialUpdate(context.Background(), 12, &Language{
+		Language: Int(4),
+		Product:  Int(3),
+		Files:    Int(120),
+		Code:     Int(15000),
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestLanguagesService_Delete(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("Expected DELETE request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/languages/12/") {
+			t.Errorf("Expected /languages/12/ in path, got %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	_, err := dj.Languages.Delete(context.Background(), 12)
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+}
+
+func TestLanguagesOptions_ToString(t *testing.T) {
+	tests := []struct {
+		name     string
+		options  *LanguagesOptions
+		expected string
+	}{
+		{
+			name: "product only",
+			options: &LanguagesOptions{
+				Product: 3,
+			},
+			expected: "?product=3",
+		},
+		{
+			name: "all fields",
+			options: &LanguagesOptions{
+				Limit:    10,
+				Language: 4,
+				Product:  3,
+			},
+			expected: "?language=4&limit=10&product=3",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := tt.options.ToString()
+			if actual != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,271 @@
+package defectdojo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"maps"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+)
+
+type MetadataService struct {
+	client *Client
+}
+
+// Metadata is a custom name/value pair attached to exactly one product, endpoint or finding.
+type Metadata struct {
+	Id       *int    `json:"id,omitempty"`
+	Product  *int    `json:"product,omitempty"`
+	Endpoint *int    `json:"endpoint,omitempty"`
+	Finding  *int    `json:"finding,omitempty"`
+	Name     *string `json:"name,omitempty"`
+	Value    *string `json:"value,omitempty"`
+}
+
+type MetadataList struct {
+	Count    *int        `json:"count,omitempty"`
+	Next     *string     `json:"next,omitempty"`
+	Previous *string     `json:"previous,omitempty"`
+	Results  *[]Metadata `json:"results,omitempty"`
+}
+
+type MetadataOptions struct {
+	Limit    int
+	Offset   int
+	ID       int
+	Product  int
+	Endpoint int
+	Finding  int
+	Name     string
+	Value    string
+}
+
+func (o *MetadataOptions) ToString() string {
+	if o == nil {
+		return ""
+	}
+
+	v := url.Values{}
+	if o.Limit > 0 {
+		v.Set("limit", strconv.Itoa(o.Limit))
+	}
+	if o.Offset > 0 {
+		v.Set("offset", strconv.Itoa(o.Offset))
+	}
+	if o.ID > 0 {
+		v.Set("id", strconv.Itoa(o.ID))
+	}
+	if o.Product > 0 {
+		v.Set("product", strconv.Itoa(o.Product))
+	}
+	if o.Endpoint > 0 {
+		v.Set("endpoint", strconv.Itoa(o.Endpoint))
+	}
+	if o.Finding > 0 {
+		v.Set("finding", strconv.Itoa(o.Finding))
+	}
+	if len(o.Name) > 0 {
+		v.Set("name", o.Name)
+	}
+	if len(o.Value) > 0 {
+		v.Set("value", o.Value)
+	}
+
+	return "?" + v.Encode()
+}
+
+func (c *MetadataService) List(ctx context.Context, options *MetadataOptions) (*MetadataList, error) {
+	path := fmt.Sprintf("%s/metadata/%s", c.client.BaseURL, options.ToString())
+
+	req, err := http.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := MetadataList{}
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// All returns an iterator over all metadata matching the given options,
+// fetching further pages from DefectDojo as the iteration advances.
+func (c *MetadataService) All(ctx context.Context, options *MetadataOptions) iter.Seq2[Metadata, error] {
+	path := fmt.Sprintf("%s/metadata/%s", c.client.BaseURL, options.ToString())
+
+	return all[Metadata](ctx, c.client, path)
+}
+
+func (c *MetadataService) Read(ctx context.Context, id int) (*Metadata, error) {
+	path := fmt.Sprintf("%s/metadata/%d/", c.client.BaseURL, id)
+
+	req, err := http.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(Metadata)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *MetadataService) Create(ctx context.Context, u *Metadata) (*Metadata, error) {
+	path := fmt.Sprintf("%s/metadata/", c.client.BaseURL)
+
+	postJSON, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPost, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(Metadata)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *MetadataService) Update(ctx context.Context, id int, u *Metadata) (*Metadata, error) {
+	path := fmt.Sprintf("%s/metadata/%d/", c.client.BaseURL, id)
+
+	postJSON, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPut, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(Metadata)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *MetadataService) PartialUpdate(ctx context.Context, id int, u *Metadata) (*Metadata, error) {
+	path := fmt.Sprintf("%s/metadata/%d/", c.client.BaseURL, id)
+
+	postJSON, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPatch, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(Metadata)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *MetadataService) Delete(ctx context.Context, id int) (*Metadata, error) {
+	path := fmt.Sprintf("%s/metadata/%d/", c.client.BaseURL, id)
+
+	req, err := http.NewRequest(http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(Metadata)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// SetProductMeta makes values the metadata of the product: missing names are created,
+// changed values are updated and names absent from values are deleted.
+func (c *MetadataService) SetProductMeta(ctx context.Context, productID int, values map[string]string) error {
+	// a zero ID would drop the owner filter and match the metadata of every object
+	if productID <= 0 {
+		return fmt.Errorf("SetProductMeta: invalid product %d", productID)
+	}
+	return c.set(ctx, &MetadataOptions{Product: productID}, Metadata{Product: Int(productID)}, values)
+}
+
+// SetEndpointMeta makes values the metadata of the endpoint, like SetProductMeta.
+func (c *MetadataService) SetEndpointMeta(ctx context.Context, endpointID int, values map[string]string) error {
+	if endpointID <= 0 {
+		return fmt.Errorf("SetEndpointMeta: invalid endpoint %d", endpointID)
+	}
+	return c.set(ctx, &MetadataOptions{Endpoint: endpointID}, Metadata{Endpoint: Int(endpointID)}, values)
+}
+
+// SetFindingMeta makes values the metadata of the finding, like SetProductMeta.
+func (c *MetadataService) SetFindingMeta(ctx context.Context, findingID int, values map[string]string) error {
+	if findingID <= 0 {
+		return fmt.Errorf("SetFindingMeta: invalid finding %d", findingID)
+	}
+	return c.set(ctx, &MetadataOptions{Finding: findingID}, Metadata{Finding: Int(findingID)}, values)
+}
+
+func (c *MetadataService) set(ctx context.Context, options *MetadataOptions, owner Metadata, values map[string]string) error {
+	existing := make(map[string]Metadata)
+	for m, err := range c.All(ctx, options) {
+		if err != nil {
+			return err
+		}
+		if m.Name != nil {
+			existing[*m.Name] = m
+		}
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(values)) {
+		m, ok := existing[name]
+		delete(existing, name)
+		switch {
+		case !ok:
+			u := owner
+			u.Name, u.Value = Str(name), Str(values[name])
+			if _, err := c.Create(ctx, &u); err != nil {
+				return err
+			}
+		case m.Value == nil || *m.Value != values[name]:
+			if _, err := c.PartialUpdate(ctx, *m.Id, &Metadata{Value: Str(values[name])}); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(existing)) {
+		if _, err := c.Delete(ctx, *existing[name].Id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,361 @@
+package defectdojo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestMetadataService_List(t *testing.T) {
+	response := `{
+		"count": 1,
+		"next": null,
+		"previous": null,
+		"results": [
+			{
+				"id": 8,
+				"product": 3,
+				"name": "cost-centre",
+				"value": "CC-1234"
+			}
+		]
+	}`
+
+	expected := MetadataList{
+		Count:    Int(1),
+		Next:     nil,
+		Previous: nil,
+		Results: &[]Metadata{
+			{
+				Id:      Int(8),
+				Product: Int(3),
+				Name:    Str("cost-centre"),
+				Value:   Str("CC-1234"),
+			},
+		},
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected GET request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/metadata/") {
+			t.Errorf("Expected /metadata/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.Metadata.List(context.Background(), &MetadataOptions{
+		Limit: 10,
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestMetadataService_Read(t *testing.T) {
+	response := `{
+		"id": 8,
+		"product": 3,
+		"name": "cost-centre",
+		"value": "CC-1234"
+	}`
+
+	expected := Metadata{
+		Id:      Int(8),
+		Product: Int(3),
+		Name:    Str("cost-centre"),
+		Value:   Str("CC-1234"),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected GET request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/metadata/8/") {
+			t.Errorf("Expected /metadata/8/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.Metadata.Read(context.Background(), 8)
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestMetadataService_Create(t *testing.T) {
+	response := `{
+		"id": 8,
+		"product": 3,
+		"name": "cost-centre",
+		"value": "CC-1234"
+	}`
+
+	expected := Metadata{
+		Id:      Int(8),
+		Product: Int(3),
+		Name:    Str("cost-centre"),
+		Value:   Str("CC-1234"),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("Expected POST request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/metadata/") {
+			t.Errorf("Expected /metadata/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.Metadata.Create(context.Background(), &Metadata{
+		Product: Int(3),
+		Name:    Str("cost-centre"),
+		Value:   Str("CC-1234"),
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestMetadataService_Update(t *testing.T) {
+	response := `{
+		"id": 8,
+		"product": 3,
+		"name": "cost-centre",
+		"value": "CC-1234"
+	}`
+
+	expected := Metadata{
+		Id:      Int(8),
+		Product: Int(3),
+		Name:    Str("cost-centre"),
+		Value:   Str("CC-1234"),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Errorf("Expected PUT request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/metadata/8/") {
+			t.Errorf("Expected /metadata/8/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.Metadata.Update(context.Background(), 8, &Metadata{
+		Product: Int(3),
+		Name:    Str("cost-centre"),
+		Value:   Str("CC-1234"),
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestMetadataService_PartialUpdate(t *testing.T) {
+	response := `{
+		"id": 8,
+		"product": 3,
+		"name": "cost-centre",
+		"value": "CC-1234"
+	}`
+
+	expected := Metadata{
+		Id:      Int(8),
+		Product: Int(3),
+		Name:    Str("cost-centre"),
+		Value:   Str("CC-1234"),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch {
+			t.Errorf("Expected PATCH request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/metadata/8/") {
+			t.Errorf("Expected /metadata/8/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.Metadata.PartialUpdate(context.Background(), 8, &Metadata{
+		Product: Int(3),
+		Name:    Str("cost-centre"),
+		Value:   Str("CC-1234"),
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestMetadataService_Delete(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("Expected DELETE request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/metadata/8/") {
+			t.Errorf("Expected /metadata/8/ in path, got %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	_, err := dj.Metadata.Delete(context.Background(), 8)
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+}
+
+func TestMetadataOptions_ToString(t *testing.T) {
+	tests := []struct {
+		name     string
+		options  *MetadataOptions
+		expected string
+	}{
+		{
+			name: "product only",
+			options: &MetadataOptions{
+				Product: 3,
+			},
+			expected: "?product=3",
+		},
+		{
+			name: "all fields",
+			options: &MetadataOptions{
+				Limit:   10,
+				Finding: 42,
+				Name:    "owner",
+				Value:   "team a",
+			},
+			expected: "?finding=42&limit=10&name=owner&value=team+a",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := tt.options.ToString()
+			if actual != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestMetadataService_SetProductMeta(t *testing.T) {
+	var requests []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			if r.URL.Query().Get("product") != "3" {
+				t.Errorf("Expected product=3 in query, got %s", r.URL.RawQuery)
+			}
+			_, _ = fmt.Fprintln(w, `{"count": 3, "next": null, "previous": null, "results": [
+				{"id": 1, "product": 3, "name": "owner", "value": "team-a"},
+				{"id": 2, "product": 3, "name": "cost-centre", "value": "CC-1"},
+				{"id": 3, "product": 3, "name": "legacy", "value": "yes"}
+			]}`)
+			return
+		}
+
+		var body Metadata
+		if r.Method != http.MethodDelete {
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("cannot decode body: %s", err)
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+		}
+		b, _ := json.Marshal(&body)
+		requests = append(requests, fmt.Sprintf("%s %s %s", r.Method, r.URL.Path, b))
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		_, _ = fmt.Fprintln(w, `{"id": 4}`)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	err := dj.Metadata.SetProductMeta(context.Background(), 3, map[string]string{
+		"owner":               "team-a",
+		"cost-centre":         "CC-2",
+		"data-classification": "confidential",
+	})
+	if !cmp.Equal(err, nil) {
+		t.Fatalf("error: %s", err)
+	}
+
+	expected := []string{
+		`PATCH /api/v2/metadata/2/ {"value":"CC-2"}`,
+		`POST /api/v2/metadata/ {"product":3,"name":"data-classification","value":"confidential"}`,
+		`DELETE /api/v2/metadata/3/ {}`,
+	}
+	if !cmp.Equal(requests, expected) {
+		t.Errorf("should have been equal, %v, %v", requests, expected)
+	}
+}
+
+func TestMetadataService_SetMeta_invalidID(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected %s request to %s", r.Method, r.URL.String())
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+	ctx := context.Background()
+	values := map[string]string{"owner": "team-a"}
+
+	if err := dj.Metadata.SetProductMeta(ctx, 0, values); cmp.Equal(err, nil) {
+		t.Errorf("expected an error for product 0")
+	}
+	if err := dj.Metadata.SetEndpointMeta(ctx, -1, values); cmp.Equal(err, nil) {
+		t.Errorf("expected an error for endpoint -1")
+	}
+	if err := dj.Metadata.SetFindingMeta(ctx, 0, nil); cmp.Equal(err, nil) {
+		t.Errorf("expected an error for finding 0")
+	}
+}
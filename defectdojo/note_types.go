@@ -0,0 +1,205 @@
+package defectdojo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+type NoteTypesService struct {
+	client *Client
+}
+
+type NoteType struct {
+	Id          *int    `json:"id,omitempty"`
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	IsSingle    *bool   `json:"is_single,omitempty"`
+	IsActive    *bool   `json:"is_active,omitempty"`
+	IsMandatory *bool   `json:"is_mandatory,omitempty"`
+}
+
+type NoteTypes struct {
+	Count    *int        `json:"count,omitempty"`
+	Next     *string     `json:"next,omitempty"`
+	Previous *string     `json:"previous,omitempty"`
+	Results  *[]NoteType `json:"results,omitempty"`
+}
+
+type NoteTypesOptions struct {
+	Limit       int
+	Offset      int
+	ID          int
+	Name        string
+	Description string
+	IsSingle    string
+	IsActive    string
+	IsMandatory string
+}
+
+func (o *NoteTypesOptions) ToString() string {
+	if o == nil {
+		return ""
+	}
+
+	v := url.Values{}
+	if o.Limit > 0 {
+		v.Set("limit", strconv.Itoa(o.Limit))
+	}
+	if o.Offset > 0 {
+		v.Set("offset", strconv.Itoa(o.Offset))
+	}
+	if o.ID > 0 {
+		v.Set("id", strconv.Itoa(o.ID))
+	}
+	if len(o.Name) > 0 {
+		v.Set("name", o.Name)
+	}
+	if len(o.Description) > 0 {
+		v.Set("description", o.Description)
+	}
+	if len(o.IsSingle) > 0 {
+		v.Set("is_single", o.IsSingle)
+	}
+	if len(o.IsActive) > 0 {
+		v.Set("is_active", o.IsActive)
+	}
+	if len(o.IsMandatory) > 0 {
+		v.Set("is_mandatory", o.IsMandatory)
+	}
+
+	return "?" + v.Encode()
+}
+
+func (c *NoteTypesService) List(ctx context.Context, options *NoteTypesOptions) (*NoteTypes, error) {
+	path := fmt.Sprintf("%s/note_type/%s", c.client.BaseURL, options.ToString())
+
+	req, err := http.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := NoteTypes{}
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// All returns an iterator over all note types matching the given options,
+// fetching further pages from DefectDojo as the iteration advances.
+func (c *NoteTypesService) All(ctx context.Context, options *NoteTypesOptions) iter.Seq2[NoteType, error] {
+	path := fmt.Sprintf("%s/note_type/%s", c.client.BaseURL, options.ToString())
+
+	return all[NoteType](ctx, c.client, path)
+}
+
+func (c *NoteTypesService) Read(ctx context.Context, id int) (*NoteType, error) {
+	path := fmt.Sprintf("%s/note_type/%d/", c.client.BaseURL, id)
+
+	req, err := http.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(NoteType)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *NoteTypesService) Create(ctx context.Context, u *NoteType) (*NoteType, error) {
+	path := fmt.Sprintf("%s/note_type/", c.client.BaseURL)
+
+	postJSON, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPost, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(NoteType)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *NoteTypesService) Update(ctx context.Context, id int, u *NoteType) (*NoteType, error) {
+	path := fmt.Sprintf("%s/note_type/%d/", c.client.BaseURL, id)
+
+	postJSON, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPut, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(NoteType)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *NoteTypesService) PartialUpdate(ctx context.Context, id int, u *NoteType) (*NoteType, error) {
+	path := fmt.Sprintf("%s/note_type/%d/", c.client.BaseURL, id)
+
+	postJSON, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPatch, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(NoteType)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *NoteTypesService) Delete(ctx context.Context, id int) (*NoteType, error) {
+	path := fmt.Sprintf("%s/note_type/%d/", c.client.BaseURL, id)
+
+	req, err := http.NewRequest(http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(NoteType)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
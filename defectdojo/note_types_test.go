@@ -0,0 +1,302 @@
+package defectdojo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestNoteTypesService_List(t *testing.T) {
+	response := `{
+		"count": 1,
+		"next": null,
+		"previous": null,
+		"results": [
+			{
+				"id": 2,
+				"name": "Remediation",
+				"description": "Remediation notes",
+				"is_single": false,
+				"is_active": true,
+				"is_mandatory": false
+			}
+		]
+	}`
+
+	expected := NoteTypes{
+		Count:    Int(1),
+		Next:     nil,
+		Previous: nil,
+		Results: &[]NoteType{
+			{
+				Id:          Int(2),
+				Name:        Str("Remediation"),
+				Description: Str("Remediation notes"),
+				IsSingle:    Bool(false),
+				IsActive:    Bool(true),
+				IsMandatory: Bool(false),
+			},
+		},
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected GET request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/note_type/") {
+			t.Errorf("Expected /note_type/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.NoteTypes.List(context.Background(), &NoteTypesOptions{
+		Limit: 10,
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestNoteTypesService_Read(t *testing.T) {
+	response := `{
+		"id": 2,
+		"name": "Remediation",
+		"description": "Remediation notes",
+		"is_single": false,
+		"is_active": true,
+		"is_mandatory": false
+	}`
+
+	expected := NoteType{
+		Id:          Int(2),
+		Name:        Str("Remediation"),
+		Description: Str("Remediation notes"),
+		IsSingle:    Bool(false),
+		IsActive:    Bool(true),
+		IsMandatory: Bool(false),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected GET request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/note_type/2/") {
+			t.Errorf("Expected /note_type/2/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.NoteTypes.Read(context.Background(), 2)
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestNoteTypesService_Create(t *testing.T) {
+	response := `{
+		"id": 2,
+		"name": "Remediation",
+		"description": "Remediation notes",
+		"is_single": false,
+		"is_active": true,
+		"is_mandatory": false
+	}`
+
+	expected := NoteType{
+		Id:          Int(2),
+		Name:        Str("Remediation"),
+		Description: Str("Remediation notes"),
+		IsSingle:    Bool(false),
+		IsActive:    Bool(true),
+		IsMandatory: Bool(false),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("Expected POST request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/note_type/") {
+			t.Errorf("Expected /note_type/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.NoteTypes.Create(context.Background(), &NoteType{
+		Name:        Str("Remediation"),
+		Description: Str("Remediation notes"),
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestNoteTypesService_Update(t *testing.T) {
+	response := `{
+		"id": 2,
+		"name": "Remediation",
+		"description": "Remediation notes",
+		"is_single": false,
+		"is_active": true,
+		"is_mandatory": false
+	}`
+
+	expected := NoteType{
+		Id:          Int(2),
+		Name:        Str("Remediation"),
+		Description: Str("Remediation notes"),
+		IsSingle:    Bool(false),
+		IsActive:    Bool(true),
+		IsMandatory: Bool(false),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Errorf("Expected PUT request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/note_type/2/") {
+			t.Errorf("Expected /note_type/2/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.NoteTypes.Update(context.Background(), 2, &NoteType{
+		Name:        Str("Remediation"),
+		Description: Str("Remediation notes"),
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestNoteTypesService_PartialUpdate(t *testing.T) {
+	response := `{
+		"id": 2,
+		"name": "Remediation",
+		"description": "Remediation notes",
+		"is_single": false,
+		"is_active": true,
+		"is_mandatory": false
+	}`
+
+	expected := NoteType{
+		Id:          Int(2),
+		Name:        Str("Remediation"),
+		Description: Str("Remediation notes"),
+		IsSingle:    Bool(false),
+		IsActive:    Bool(true),
+		IsMandatory: Bool(false),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch {
+			t.Errorf("Expected PATCH request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/note_type/2/") {
+			t.Errorf("Expected /note_type/2/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.NoteTypes.PartialUpdate(context.Background(), 2, &NoteType{
+		Name:        Str("Remediation"),
+		Description: Str("Remediation notes"),
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestNoteTypesService_Delete(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("Expected DELETE request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/note_type/2/") {
+			t.Errorf("Expected /note_type/2/ in path, got %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	_, err := dj.NoteTypes.Delete(context.Background(), 2)
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+}
+
+func TestNoteTypesOptions_ToString(t *testing.T) {
+	tests := []struct {
+		name     string
+		options  *NoteTypesOptions
+		expected string
+	}{
+		{
+			name: "name only",
+			options: &NoteTypesOptions{
+				Name: "Remediation",
+			},
+			expected: "?name=Remediation",
+		},
+		{
+			name: "all fields",
+			options: &NoteTypesOptions{
+				Limit:    10,
+				Offset:   20,
+				IsActive: "true",
+				Name:     "Remediation & Fix",
+			},
+			expected: "?is_active=true&limit=10&name=Remediation+%26+Fix&offset=20",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := tt.options.ToString()
+			if actual != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, actual)
+			}
+		})
+	}
+}
@@ -1,6 +1,16 @@
 package defectdojo
 
-import "time"
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
 
 type NotesService struct {
 	client *Client
@@ -30,9 +40,153 @@ type Note struct {
 	NoteType *int       `json:"note_type,omitempty"`
 }
 
-type Notes *struct {
+type Notes struct {
 	Count    *int    `json:"count,omitempty"`
 	Next     *string `json:"next,omitempty"`
 	Previous *string `json:"previous,omitempty"`
 	Results  *[]Note `json:"results,omitempty"`
 }
+
+// NewNote holds the parameters of a note added to a finding, test or engagement.
+type NewNote struct {
+	Entry    *string `json:"entry,omitempty"`
+	Private  *bool   `json:"private,omitempty"`
+	NoteType *int    `json:"note_type,omitempty"`
+}
+
+type NotesOptions struct {
+	Limit    int
+	Offset   int
+	ID       int
+	Author   int
+	Entry    string
+	Private  string
+	NoteType int
+	Date     string
+	Edited   string
+}
+
+func (o *NotesOptions) ToString() string {
+	if o == nil {
+		return ""
+	}
+
+	v := url.Values{}
+	if o.Limit > 0 {
+		v.Set("limit", strconv.Itoa(o.Limit))
+	}
+	if o.Offset > 0 {
+		v.Set("offset", strconv.Itoa(o.Offset))
+	}
+	if o.ID > 0 {
+		v.Set("id", strconv.Itoa(o.ID))
+	}
+	if o.Author > 0 {
+		v.Set("author", strconv.Itoa(o.Author))
+	}
+	if len(o.Entry) > 0 {
+		v.Set("entry", o.Entry)
+	}
+	if len(o.Private) > 0 {
+		v.Set("private", o.Private)
+	}
+	if o.NoteType > 0 {
+		v.Set("note_type", strconv.Itoa(o.NoteType))
+	}
+	if len(o.Date) > 0 {
+		v.Set("date", o.Date)
+	}
+	if len(o.Edited) > 0 {
+		v.Set("edited", o.Edited)
+	}
+
+	return "?" + v.Encode()
+}
+
+func (c *NotesService) List(ctx context.Context, options *NotesOptions) (*Notes, error) {
+	path := fmt.Sprintf("%s/notes/%s", c.client.BaseURL, options.ToString())
+
+	req, err := http.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := Notes{}
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// All returns an iterator over all notes matching the given options,
+// fetching further pages from DefectDojo as the iteration advances.
+func (c *NotesService) All(ctx context.Context, options *NotesOptions) iter.Seq2[Note, error] {
+	path := fmt.Sprintf("%s/notes/%s", c.client.BaseURL, options.ToString())
+
+	return all[Note](ctx, c.client, path)
+}
+
+func (c *NotesService) Read(ctx context.Context, id int) (*Note, error) {
+	path := fmt.Sprintf("%s/notes/%d/", c.client.BaseURL, id)
+
+	req, err := http.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(Note)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *NotesService) Update(ctx context.Context, id int, u *Note) (*Note, error) {
+	path := fmt.Sprintf("%s/notes/%d/", c.client.BaseURL, id)
+
+	postJSON, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPut, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(Note)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *NotesService) PartialUpdate(ctx context.Context, id int, u *Note) (*Note, error) {
+	path := fmt.Sprintf("%s/notes/%d/", c.client.BaseURL, id)
+
+	postJSON, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPatch, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(Note)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
@@ -0,0 +1,241 @@
+package defectdojo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestNotesService_List(t *testing.T) {
+	response := `{
+		"count": 1,
+		"next": null,
+		"previous": null,
+		"results": [
+			{
+				"id": 4,
+				"entry": "Confirmed with the product team",
+				"date": "2024-03-01T10:00:00Z",
+				"private": false,
+				"edited": false,
+				"note_type": 2
+			}
+		]
+	}`
+
+	expected := Notes{
+		Count:    Int(1),
+		Next:     nil,
+		Previous: nil,
+		Results: &[]Note{
+			{
+				Id:       Int(4),
+				Entry:    Str("Confirmed with the product team"),
+				Date:     Date(time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)),
+				Private:  Bool(false),
+				Edited:   Bool(false),
+				NoteType: Int(2),
+			},
+		},
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected GET request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/notes/") {
+			t.Errorf("Expected /notes/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.Notes.List(context.Background(), &NotesOptions{
+		Limit: 10,
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestNotesService_Read(t *testing.T) {
+	response := `{
+		"id": 4,
+		"entry": "Confirmed with the product team",
+		"date": "2024-03-01T10:00:00Z",
+		"private": false,
+		"edited": false,
+		"note_type": 2
+	}`
+
+	expected := Note{
+		Id:       Int(4),
+		Entry:    Str("Confirmed with the product team"),
+		Date:     Date(time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)),
+		Private:  Bool(false),
+		Edited:   Bool(false),
+		NoteType: Int(2),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected GET request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/notes/4/") {
+			t.Errorf("Expected /notes/4/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.Notes.Read(context.Background(), 4)
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestNotesService_Update(t *testing.T) {
+	response := `{
+		"id": 4,
+		"entry": "Confirmed with the product team",
+		"date": "2024-03-01T10:00:00Z",
+		"private": false,
+		"edited": false,
+		"note_type": 2
+	}`
+
+	expected := Note{
+		Id:       Int(4),
+		Entry:    Str("Confirmed with the product team"),
+		Date:     Date(time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)),
+		Private:  Bool(false),
+		Edited:   Bool(false),
+		NoteType: Int(2),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Errorf("Expected PUT request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/notes/4/") {
+			t.Errorf("Expected /notes/4/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.Notes.Update(context.Background(), 4, &Note{
+		Entry: Str("Confirmed with the product team"),
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestNotesService_PartialUpdate(t *testing.T) {
+	response := `{
+		"id": 4,
+		"entry": "Confirmed with the product team",
+		"date": "2024-03-01T10:00:00Z",
+		"private": false,
+		"edited": false,
+		"note_type": 2
+	}`
+
+	expected := Note{
+		Id:       Int(4),
+		Entry:    Str("Confirmed with the product team"),
+		Date:     Date(time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)),
+		Private:  Bool(false),
+		Edited:   Bool(false),
+		NoteType: Int(2),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch {
+			t.Errorf("Expected PATCH request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/notes/4/") {
+			t.Errorf("Expected /notes/4/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.Notes.PartialUpdate(context.Background(), 4, &Note{
+		Entry: Str("Confirmed with the product team"),
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestNotesOptions_ToString(t *testing.T) {
+	tests := []struct {
+		name     string
+		options  *NotesOptions
+		expected string
+	}{
+		{
+			name: "author only",
+			options: &NotesOptions{
+				Author: 3,
+			},
+			expected: "?author=3",
+		},
+		{
+			name: "all fields",
+			options: &NotesOptions{
+				Limit:    10,
+				Offset:   20,
+				ID:       4,
+				Author:   3,
+				Entry:    "false positive",
+				Private:  "false",
+				NoteType: 2,
+				Date:     "2024-03-01",
+				Edited:   "true",
+			},
+			expected: "?author=3&date=2024-03-01&edited=true&entry=false+positive&id=4&limit=10&note_type=2&offset=20&private=false",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := tt.options.ToString()
+			if actual != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package defectdojo
+
+import (
+	"context"
+	"iter"
+	"net/http"
+)
+
+// page is the generic shape of every paginated list response returned by the DefectDojo API.
+type page[T any] struct {
+	Count    *int    `json:"count,omitempty"`
+	Next     *string `json:"next,omitempty"`
+	Previous *string `json:"previous,omitempty"`
+	Results  *[]T    `json:"results,omitempty"`
+}
+
+// all returns an iterator over every result of the list endpoint at path,
+// transparently following the "next" link of each page.
+// Iteration stops at the first error, which is yielded together with the zero value of T,
+// or as soon as the context is cancelled.
+func all[T any](ctx context.Context, c *Client, path string) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+
+		for next := path; len(next) > 0; {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+
+			req, err := http.NewRequest(http.MethodGet, next, nil)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+
+			req = req.WithContext(ctx)
+
+			res := page[T]{}
+			if err := c.sendRequest(req, &res); err != nil {
+				yield(zero, err)
+				return
+			}
+
+			if res.Results != nil {
+				for _, v := range *res.Results {
+					if !yield(v, nil) {
+						return
+					}
+				}
+			}
+
+			next = ""
+			if res.Next != nil {
+				next = *res.Next
+			}
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package defectdojo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestFindingsService_All(t *testing.T) {
+	var ts *httptest.Server
+	ts = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected GET request, got %s", r.Method)
+		}
+		switch r.URL.Query().Get("offset") {
+		case "":
+			_, _ = fmt.Fprintf(w, `{"count": 3, "next": "%s/api/v2/findings/?limit=2&offset=2", "previous": null, "results": [{"id": 1}, {"id": 2}]}`, ts.URL)
+		case "2":
+			_, _ = fmt.Fprintln(w, `{"count": 3, "next": null, "previous": null, "results": [{"id": 3}]}`)
+		default:
+			t.Errorf("unexpected offset %s", r.URL.Query().Get("offset"))
+		}
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	var ids []int
+	for f, err := range dj.Findings.All(context.Background(), &FindingsOptions{Limit: 2}) {
+		if !cmp.Equal(err, nil) {
+			t.Fatalf("error: %s", err)
+		}
+		ids = append(ids, *f.Id)
+	}
+
+	if !cmp.Equal(ids, []int{1, 2, 3}) {
+		t.Errorf("should have been equal, %v, %v", ids, []int{1, 2, 3})
+	}
+}
+
+func TestAll_stopsOnError(t *testing.T) {
+	var ts *httptest.Server
+	ts = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("offset") == "1" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"count": 2, "next": "%s/api/v2/products/?offset=1", "results": [{"id": 1}]}`, ts.URL)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	var results, errs int
+	for _, err := range dj.Products.All(context.Background(), nil) {
+		if err != nil {
+			errs++
+			continue
+		}
+		results++
+	}
+
+	if results != 1 || errs != 1 {
+		t.Errorf("expected 1 result and 1 error, got %d results and %d errors", results, errs)
+	}
+}
+
+func TestAll_contextCancelled(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("no request should have been sent")
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	for _, err := range dj.Tests.All(ctx, nil) {
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("expected context.Canceled, got %v", err)
+		}
+	}
+}
+
+func TestAll_breakStopsPaging(t *testing.T) {
+	requests := 0
+	var ts *httptest.Server
+	ts = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		_, _ = fmt.Fprintf(w, `{"count": 4, "next": "%s/api/v2/users/?offset=2", "results": [{"id": 1}, {"id": 2}]}`, ts.URL)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	for u, err := range dj.Users.All(context.Background(), nil) {
+		if !cmp.Equal(err, nil) {
+			t.Fatalf("error: %s", err)
+		}
+		if *u.ID == 1 {
+			break
+		}
+	}
+
+	if requests != 1 {
+		t.Errorf("expected 1 request, got %d", requests)
+	}
+}
@@ -0,0 +1,244 @@
+package defectdojo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+type ProductGroupsService struct {
+	client *Client
+}
+
+type ProductGroup struct {
+	Id      *int    `json:"id,omitempty"`
+	Product *int    `json:"product,omitempty"`
+	Group   *int    `json:"group,omitempty"`
+	Role    *RoleID `json:"role,omitempty"`
+}
+
+type ProductGroups struct {
+	Count    *int            `json:"count,omitempty"`
+	Next     *string         `json:"next,omitempty"`
+	Previous *string         `json:"previous,omitempty"`
+	Results  *[]ProductGroup `json:"results,omitempty"`
+}
+
+type ProductGroupsOptions struct {
+	Limit   int
+	Offset  int
+	ID      int
+	Product int
+	Group   int
+	Role    RoleID
+}
+
+func (o *ProductGroupsOptions) ToString() string {
+	if o == nil {
+		return ""
+	}
+
+	v := url.Values{}
+	if o.Limit > 0 {
+		v.Set("limit", strconv.Itoa(o.Limit))
+	}
+	if o.Offset > 0 {
+		v.Set("offset", strconv.Itoa(o.Offset))
+	}
+	if o.ID > 0 {
+		v.Set("id", strconv.Itoa(o.ID))
+	}
+	if o.Product > 0 {
+		v.Set("product_id", strconv.Itoa(o.Product))
+	}
+	if o.Group > 0 {
+		v.Set("group_id", strconv.Itoa(o.Group))
+	}
+	if o.Role > 0 {
+		v.Set("role_id", strconv.Itoa(int(o.Role)))
+	}
+
+	return "?" + v.Encode()
+}
+
+func (c *ProductGroupsService) List(ctx context.Context, options *ProductGroupsOptions) (*ProductGroups, error) {
+	path := fmt.Sprintf("%s/product_groups/%s", c.client.BaseURL, options.ToString())
+
+	req, err := http.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := ProductGroups{}
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// All returns an iterator over all product groups matching the given options,
+// fetching further pages from DefectDojo as the iteration advances.
+func (c *ProductGroupsService) All(ctx context.Context, options *ProductGroupsOptions) iter.Seq2[ProductGroup, error] {
+	path := fmt.Sprintf("%s/product_groups/%s", c.client.BaseURL, options.ToString())
+
+	return all[ProductGroup](ctx, c.client, path)
+}
+
+func (c *ProductGroupsService) Read(ctx context.Context, id int) (*ProductGroup, error) {
+	path := fmt.Sprintf("%s/product_groups/%d/", c.client.BaseURL, id)
+
+	req, err := http.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(ProductGroup)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *ProductGroupsService) Create(ctx context.Context, u *ProductGroup) (*ProductGroup, error) {
+	path := fmt.Sprintf("%s/product_groups/", c.client.BaseURL)
+
+	postJSON, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPost, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(ProductGroup)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *ProductGroupsService) Update(ctx context.Context, id int, u *ProductGroup) (*ProductGroup, error) {
+	path := fmt.Sprintf("%s/product_groups/%d/", c.client.BaseURL, id)
+
+	postJSON, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPut, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(ProductGroup)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *ProductGroupsService) PartialUpdate(ctx context.Context, id int, u *ProductGroup) (*ProductGroup, error) {
+	path := fmt.Sprintf("%s/product_groups/%d/", c.client.BaseURL, id)
+
+	postJSON, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPatch, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(ProductGroup)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *ProductGroupsService) Delete(ctx context.Context, id int) (*ProductGroup, error) {
+	path := fmt.Sprintf("%s/product_groups/%d/", c.client.BaseURL, id)
+
+	req, err := http.NewRequest(http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(ProductGroup)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GrantProductType gives group the role on every product of the given product type.
+// Existing memberships of the group get their role updated, missing ones are created.
+// Products added to the product type later are not covered, see ProductTypeGroupsService for that.
+func (c *ProductGroupsService) GrantProductType(ctx context.Context, productType, group int, role RoleID) ([]ProductGroup, error) {
+	// a zero ID would drop the matching filter and widen the grant to every product or membership
+	if productType <= 0 {
+		return nil, fmt.Errorf("GrantProductType: invalid product type %d", productType)
+	}
+	if group <= 0 {
+		return nil, fmt.Errorf("GrantProductType: invalid group %d", group)
+	}
+
+	existing := make(map[int]ProductGroup)
+	for m, err := range c.All(ctx, &ProductGroupsOptions{Group: group}) {
+		if err != nil {
+			return nil, err
+		}
+		if m.Product != nil && m.Group != nil && *m.Group == group {
+			existing[*m.Product] = m
+		}
+	}
+
+	var res []ProductGroup
+	for p, err := range c.client.Products.All(ctx, &ProductsOptions{ProdType: productType}) {
+		if err != nil {
+			return nil, err
+		}
+
+		m, ok := existing[*p.ID]
+		switch {
+		case !ok:
+			created, err := c.Create(ctx, &ProductGroup{Product: p.ID, Group: Int(group), Role: &role})
+			if err != nil {
+				return nil, err
+			}
+			m = *created
+		case m.Role == nil || *m.Role != role:
+			updated, err := c.PartialUpdate(ctx, *m.Id, &ProductGroup{Role: &role})
+			if err != nil {
+				return nil, err
+			}
+			m = *updated
+		}
+		res = append(res, m)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,368 @@
+package defectdojo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestProductGroupsService_List(t *testing.T) {
+	response := `{
+		"count": 1,
+		"next": null,
+		"previous": null,
+		"results": [
+			{
+				"id": 4,
+				"product": 7,
+				"group": 11,
+				"role": 3
+			}
+		]
+	}`
+
+	expected := ProductGroups{
+		Count:    Int(1),
+		Next:     nil,
+		Previous: nil,
+		Results: &[]ProductGroup{
+			{
+				Id:      Int(4),
+				Product: Int(7),
+				Group:   Int(11),
+				Role:    Ptr(RoleMaintainer),
+			},
+		},
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected GET request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/product_groups/") {
+			t.Errorf("Expected /product_groups/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.ProductGroups.List(context.Background(), &ProductGroupsOptions{
+		Limit: 10,
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestProductGroupsService_Read(t *testing.T) {
+	response := `{
+		"id": 4,
+		"product": 7,
+		"group": 11,
+		"role": 3
+	}`
+
+	expected := ProductGroup{
+		Id:      Int(4),
+		Product: Int(7),
+		Group:   Int(11),
+		Role:    Ptr(RoleMaintainer),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected GET request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/product_groups/4/") {
+			t.Errorf("Expected /product_groups/4/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.ProductGroups.Read(context.Background(), 4)
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestProductGroupsService_Create(t *testing.T) {
+	response := `{
+		"id": 4,
+		"product": 7,
+		"group": 11,
+		"role": 3
+	}`
+
+	expected := ProductGroup{
+		Id:      Int(4),
+		Product: Int(7),
+		Group:   Int(11),
+		Role:    Ptr(RoleMaintainer),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("Expected POST request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/product_groups/") {
+			t.Errorf("Expected /product_groups/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.ProductGroups.Create(context.Background(), &ProductGroup{
+		Product: Int(7),
+		Group:   Int(11),
+		Role:    Ptr(RoleMaintainer),
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestProductGroupsService_Update(t *testing.T) {
+	response := `{
+		"id": 4,
+		"product": 7,
+		"group": 11,
+		"role": 3
+	}`
+
+	expected := ProductGroup{
+		Id:      Int(4),
+		Product: Int(7),
+		Group:   Int(11),
+		Role:    Ptr(RoleMaintainer),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Errorf("Expected PUT request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/product_groups/4/") {
+			t.Errorf("Expected /product_groups/4/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.ProductGroups.Update(context.Background(), 4, &ProductGroup{
+		Product: Int(7),
+		Group:   Int(11),
+		Role:    Ptr(RoleMaintainer),
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestProductGroupsService_PartialUpdate(t *testing.T) {
+	response := `{
+		"id": 4,
+		"product": 7,
+		"group": 11,
+		"role": 3
+	}`
+
+	expected := ProductGroup{
+		Id:      Int(4),
+		Product: Int(7),
+		Group:   Int(11),
+		Role:    Ptr(RoleMaintainer),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch {
+			t.Errorf("Expected PATCH request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/product_groups/4/") {
+			t.Errorf("Expected /product_groups/4/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.ProductGroups.PartialUpdate(context.Background(), 4, &ProductGroup{
+		Product: Int(7),
+		Group:   Int(11),
+		Role:    Ptr(RoleMaintainer),
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestProductGroupsService_Delete(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("Expected DELETE request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/product_groups/4/") {
+			t.Errorf("Expected /product_groups/4/ in path, got %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	_, err := dj.ProductGroups.Delete(context.Background(), 4)
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+}
+
+func TestProductGroupsOptions_ToString(t *testing.T) {
+	tests := []struct {
+		name     string
+		options  *ProductGroupsOptions
+		expected string
+	}{
+		{
+			name: "product only",
+			options: &ProductGroupsOptions{
+				Product: 7,
+			},
+			expected: "?product_id=7",
+		},
+		{
+			name: "all fields",
+			options: &ProductGroupsOptions{
+				Limit:   10,
+				Product: 7,
+				Group:   11,
+				Role:    3,
+			},
+			expected: "?group_id=11&limit=10&product_id=7&role_id=3",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := tt.options.ToString()
+			if actual != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestProductGroupsService_GrantProductType(t *testing.T) {
+	var created, updated []ProductGroup
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v2/product_groups/":
+			if r.URL.Query().Get("group_id") != "11" {
+				t.Errorf("Expected group_id=11 in query, got %s", r.URL.RawQuery)
+			}
+			_, _ = fmt.Fprintln(w, `{"count": 4, "next": null, "previous": null, "results": [
+				{"id": 1, "product": 7, "group": 11, "role": 5},
+				{"id": 2, "product": 8, "group": 11, "role": 3},
+				{"id": 3, "product": 20, "group": 11, "role": 5},
+				{"id": 5, "product": 9, "group": 12, "role": 5}
+			]}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v2/products/":
+			if r.URL.Query().Get("prod_type") != "2" {
+				t.Errorf("Expected prod_type=2 in query, got %s", r.URL.RawQuery)
+			}
+			_, _ = fmt.Fprintln(w, `{"count": 3, "next": null, "previous": null, "results": [{"id": 7}, {"id": 8}, {"id": 9}]}`)
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v2/product_groups/":
+			var body ProductGroup
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("cannot decode body: %s", err)
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			body.Id = Int(4)
+			created = append(created, body)
+			_ = json.NewEncoder(w).Encode(&body)
+		case r.Method == http.MethodPatch && r.URL.Path == "/api/v2/product_groups/1/":
+			var body ProductGroup
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("cannot decode body: %s", err)
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			updated = append(updated, body)
+			_, _ = fmt.Fprintln(w, `{"id": 1, "product": 7, "group": 11, "role": 3}`)
+		default:
+			t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.ProductGroups.GrantProductType(context.Background(), 2, 11, RoleMaintainer)
+	if !cmp.Equal(err, nil) {
+		t.Fatalf("error: %s", err)
+	}
+
+	expected := []ProductGroup{
+		{Id: Int(1), Product: Int(7), Group: Int(11), Role: Ptr(RoleMaintainer)},
+		{Id: Int(2), Product: Int(8), Group: Int(11), Role: Ptr(RoleMaintainer)},
+		{Id: Int(4), Product: Int(9), Group: Int(11), Role: Ptr(RoleMaintainer)},
+	}
+	if !cmp.Equal(actual, expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, expected)
+	}
+	if !cmp.Equal(updated, []ProductGroup{{Role: Ptr(RoleMaintainer)}}) {
+		t.Errorf("unexpected updates %+v", updated)
+	}
+	if len(created) != 1 {
+		t.Errorf("expected 1 membership to be created, got %d", len(created))
+	}
+}
+
+func TestProductGroupsService_GrantProductType_invalidID(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	for _, ids := range [][2]int{{0, 11}, {2, 0}, {-1, 11}} {
+		if _, err := dj.ProductGroups.GrantProductType(context.Background(), ids[0], ids[1], RoleReader); cmp.Equal(err, nil) {
+			t.Errorf("expected an error for product type %d and group %d", ids[0], ids[1])
+		}
+	}
+}
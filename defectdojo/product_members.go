@@ -0,0 +1,195 @@
+package defectdojo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+type ProductMembersService struct {
+	client *Client
+}
+
+type ProductMember struct {
+	Id      *int    `json:"id,omitempty"`
+	Product *int    `json:"product,omitempty"`
+	User    *int    `json:"user,omitempty"`
+	Role    *RoleID `json:"role,omitempty"`
+}
+
+type ProductMembers struct {
+	Count    *int             `json:"count,omitempty"`
+	Next     *string          `json:"next,omitempty"`
+	Previous *string          `json:"previous,omitempty"`
+	Results  *[]ProductMember `json:"results,omitempty"`
+}
+
+type ProductMembersOptions struct {
+	Limit   int
+	Offset  int
+	ID      int
+	Product int
+	User    int
+	Role    RoleID
+}
+
+func (o *ProductMembersOptions) ToString() string {
+	if o == nil {
+		return ""
+	}
+
+	v := url.Values{}
+	if o.Limit > 0 {
+		v.Set("limit", strconv.Itoa(o.Limit))
+	}
+	if o.Offset > 0 {
+		v.Set("offset", strconv.Itoa(o.Offset))
+	}
+	if o.ID > 0 {
+		v.Set("id", strconv.Itoa(o.ID))
+	}
+	if o.Product > 0 {
+		v.Set("product_id", strconv.Itoa(o.Product))
+	}
+	if o.User > 0 {
+		v.Set("user_id", strconv.Itoa(o.User))
+	}
+	if o.Role > 0 {
+		v.Set("role_id", strconv.Itoa(int(o.Role)))
+	}
+
+	return "?" + v.Encode()
+}
+
+func (c *ProductMembersService) List(ctx context.Context, options *ProductMembersOptions) (*ProductMembers, error) {
+	path := fmt.Sprintf("%s/product_members/%s", c.client.BaseURL, options.ToString())
+
+	req, err := http.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := ProductMembers{}
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// All returns an iterator over all product members matching the given options,
+// fetching further pages from DefectDojo as the iteration advances.
+func (c *ProductMembersService) All(ctx context.Context, options *ProductMembersOptions) iter.Seq2[ProductMember, error] {
+	path := fmt.Sprintf("%s/product_members/%s", c.client.BaseURL, options.ToString())
+
+	return all[ProductMember](ctx, c.client, path)
+}
+
+func (c *ProductMembersService) Read(ctx context.Context, id int) (*ProductMember, error) {
+	path := fmt.Sprintf("%s/product_members/%d/", c.client.BaseURL, id)
+
+	req, err := http.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(ProductMember)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *ProductMembersService) Create(ctx context.Context, u *ProductMember) (*ProductMember, error) {
+	path := fmt.Sprintf("%s/product_members/", c.client.BaseURL)
+
+	postJSON, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPost, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(ProductMember)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *ProductMembersService) Update(ctx context.Context, id int, u *ProductMember) (*ProductMember, error) {
+	path := fmt.Sprintf("%s/product_members/%d/", c.client.BaseURL, id)
+
+	postJSON, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPut, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(ProductMember)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *ProductMembersService) PartialUpdate(ctx context.Context, id int, u *ProductMember) (*ProductMember, error) {
+	path := fmt.Sprintf("%s/product_members/%d/", c.client.BaseURL, id)
+
+	postJSON, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPatch, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(ProductMember)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *ProductMembersService) Delete(ctx context.Context, id int) (*ProductMember, error) {
+	path := fmt.Sprintf("%s/product_members/%d/", c.client.BaseURL, id)
+
+	req, err := http.NewRequest(http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(ProductMember)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
@@ -0,0 +1,285 @@
+package defectdojo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestProductMembersService_List(t *testing.T) {
+	response := `{
+		"count": 1,
+		"next": null,
+		"previous": null,
+		"results": [
+			{
+				"id": 4,
+				"product": 7,
+				"user": 11,
+				"role": 3
+			}
+		]
+	}`
+
+	expected := ProductMembers{
+		Count:    Int(1),
+		Next:     nil,
+		Previous: nil,
+		Results: &[]ProductMember{
+			{
+				Id:      Int(4),
+				Product: Int(7),
+				User:    Int(11),
+				Role:    Ptr(RoleMaintainer),
+			},
+		},
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected GET request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/product_members/") {
+			t.Errorf("Expected /product_members/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.ProductMembers.List(context.Background(), &ProductMembersOptions{
+		Limit: 10,
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestProductMembersService_Read(t *testing.T) {
+	response := `{
+		"id": 4,
+		"product": 7,
+		"user": 11,
+		"role": 3
+	}`
+
+	expected := ProductMember{
+		Id:      Int(4),
+		Product: Int(7),
+		User:    Int(11),
+		Role:    Ptr(RoleMaintainer),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected GET request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/product_members/4/") {
+			t.Errorf("Expected /product_members/4/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.ProductMembers.Read(context.Background(), 4)
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestProductMembersService_Create(t *testing.T) {
+	response := `{
+		"id": 4,
+		"product": 7,
+		"user": 11,
+		"role": 3
+	}`
+
+	expected := ProductMember{
+		Id:      Int(4),
+		Product: Int(7),
+		User:    Int(11),
+		Role:    Ptr(RoleMaintainer),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("Expected POST request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/product_members/") {
+			t.Errorf("Expected /product_members/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.ProductMembers.Create(context.Background(), &ProductMember{
+		Product: Int(7),
+		User:    Int(11),
+		Role:    Ptr(RoleMaintainer),
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestProductMembersService_Update(t *testing.T) {
+	response := `{
+		"id": 4,
+		"product": 7,
+		"user": 11,
+		"role": 3
+	}`
+
+	expected := ProductMember{
+		Id:      Int(4),
+		Product: Int(7),
+		User:    Int(11),
+		Role:    Ptr(RoleMaintainer),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Errorf("Expected PUT request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/product_members/4/") {
+			t.Errorf("Expected /product_members/4/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.ProductMembers.Update(context.Background(), 4, &ProductMember{
+		Product: Int(7),
+		User:    Int(11),
+		Role:    Ptr(RoleMaintainer),
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestProductMembersService_PartialUpdate(t *testing.T) {
+	response := `{
+		"id": 4,
+		"product": 7,
+		"user": 11,
+		"role": 3
+	}`
+
+	expected := ProductMember{
+		Id:      Int(4),
+		Product: Int(7),
+		User:    Int(11),
+		Role:    Ptr(RoleMaintainer),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch {
+			t.Errorf("Expected PATCH request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/product_members/4/") {
+			t.Errorf("Expected /product_members/4/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.ProductMembers.PartialUpdate(context.Background(), 4, &ProductMember{
+		Product: Int(7),
+		User:    Int(11),
+		Role:    Ptr(RoleMaintainer),
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestProductMembersService_Delete(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("Expected DELETE request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/product_members/4/") {
+			t.Errorf("Expected /product_members/4/ in path, got %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	_, err := dj.ProductMembers.Delete(context.Background(), 4)
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+}
+
+func TestProductMembersOptions_ToString(t *testing.T) {
+	tests := []struct {
+		name     string
+		options  *ProductMembersOptions
+		expected string
+	}{
+		{
+			name: "product only",
+			options: &ProductMembersOptions{
+				Product: 7,
+			},
+			expected: "?product_id=7",
+		},
+		{
+			name: "all fields",
+			options: &ProductMembersOptions{
+				Limit:   10,
+				Product: 7,
+				User:    11,
+				Role:    3,
+			},
+			expected: "?limit=10&product_id=7&role_id=3&user_id=11",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := tt.options.ToString()
+			if actual != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,195 @@
+package defectdojo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+type ProductTypeGroupsService struct {
+	client *Client
+}
+
+type ProductTypeGroup struct {
+	Id          *int    `json:"id,omitempty"`
+	ProductType *int    `json:"product_type,omitempty"`
+	Group       *int    `json:"group,omitempty"`
+	Role        *RoleID `json:"role,omitempty"`
+}
+
+type ProductTypeGroups struct {
+	Count    *int                `json:"count,omitempty"`
+	Next     *string             `json:"next,omitempty"`
+	Previous *string             `json:"previous,omitempty"`
+	Results  *[]ProductTypeGroup `json:"results,omitempty"`
+}
+
+type ProductTypeGroupsOptions struct {
+	Limit       int
+	Offset      int
+	ID          int
+	ProductType int
+	Group       int
+	Role        RoleID
+}
+
+func (o *ProductTypeGroupsOptions) ToString() string {
+	if o == nil {
+		return ""
+	}
+
+	v := url.Values{}
+	if o.Limit > 0 {
+		v.Set("limit", strconv.Itoa(o.Limit))
+	}
+	if o.Offset > 0 {
+		v.Set("offset", strconv.Itoa(o.Offset))
+	}
+	if o.ID > 0 {
+		v.Set("id", strconv.Itoa(o.ID))
+	}
+	if o.ProductType > 0 {
+		v.Set("product_type_id", strconv.Itoa(o.ProductType))
+	}
+	if o.Group > 0 {
+		v.Set("group_id", strconv.Itoa(o.Group))
+	}
+	if o.Role > 0 {
+		v.Set("role_id", strconv.Itoa(int(o.Role)))
+	}
+
+	return "?" + v.Encode()
+}
+
+func (c *ProductTypeGroupsService) List(ctx context.Context, options *ProductTypeGroupsOptions) (*ProductTypeGroups, error) {
+	path := fmt.Sprintf("%s/product_type_groups/%s", c.client.BaseURL, options.ToString())
+
+	req, err := http.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := ProductTypeGroups{}
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// All returns an iterator over all product type groups matching the given options,
+// fetching further pages from DefectDojo as the iteration advances.
+func (c *ProductTypeGroupsService) All(ctx context.Context, options *ProductTypeGroupsOptions) iter.Seq2[ProductTypeGroup, error] {
+	path := fmt.Sprintf("%s/product_type_groups/%s", c.client.BaseURL, options.ToString())
+
+	return all[ProductTypeGroup](ctx, c.client, path)
+}
+
+func (c *ProductTypeGroupsService) Read(ctx context.Context, id int) (*ProductTypeGroup, error) {
+	path := fmt.Sprintf("%s/product_type_groups/%d/", c.client.BaseURL, id)
+
+	req, err := http.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(ProductTypeGroup)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *ProductTypeGroupsService) Create(ctx context.Context, u *ProductTypeGroup) (*ProductTypeGroup, error) {
+	path := fmt.Sprintf("%s/product_type_groups/", c.client.BaseURL)
+
+	postJSON, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPost, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(ProductTypeGroup)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *ProductTypeGroupsService) Update(ctx context.Context, id int, u *ProductTypeGroup) (*ProductTypeGroup, error) {
+	path := fmt.Sprintf("%s/product_type_groups/%d/", c.client.BaseURL, id)
+
+	postJSON, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPut, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(ProductTypeGroup)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *ProductTypeGroupsService) PartialUpdate(ctx context.Context, id int, u *ProductTypeGroup) (*ProductTypeGroup, error) {
+	path := fmt.Sprintf("%s/product_type_groups/%d/", c.client.BaseURL, id)
+
+	postJSON, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPatch, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(ProductTypeGroup)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *ProductTypeGroupsService) Delete(ctx context.Context, id int) (*ProductTypeGroup, error) {
+	path := fmt.Sprintf("%s/product_type_groups/%d/", c.client.BaseURL, id)
+
+	req, err := http.NewRequest(http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(ProductTypeGroup)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
@@ -0,0 +1,285 @@
+package defectdojo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestProductTypeGroupsService_List(t *testing.T) {
+	response := `{
+		"count": 1,
+		"next": null,
+		"previous": null,
+		"results": [
+			{
+				"id": 4,
+				"product_type": 7,
+				"group": 11,
+				"role": 3
+			}
+		]
+	}`
+
+	expected := ProductTypeGroups{
+		Count:    Int(1),
+		Next:     nil,
+		Previous: nil,
+		Results: &[]ProductTypeGroup{
+			{
+				Id:          Int(4),
+				ProductType: Int(7),
+				Group:       Int(11),
+				Role:        Ptr(RoleMaintainer),
+			},
+		},
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected GET request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/product_type_groups/") {
+			t.Errorf("Expected /product_type_groups/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.ProductTypeGroups.List(context.Background(), &ProductTypeGroupsOptions{
+		Limit: 10,
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestProductTypeGroupsService_Read(t *testing.T) {
+	response := `{
+		"id": 4,
+		"product_type": 7,
+		"group": 11,
+		"role": 3
+	}`
+
+	expected := ProductTypeGroup{
+		Id:          Int(4),
+		ProductType: Int(7),
+		Group:       Int(11),
+		Role:        Ptr(RoleMaintainer),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected GET request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/product_type_groups/4/") {
+			t.Errorf("Expected /product_type_groups/4/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.ProductTypeGroups.Read(context.Background(), 4)
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestProductTypeGroupsService_Create(t *testing.T) {
+	response := `{
+		"id": 4,
+		"product_type": 7,
+		"group": 11,
+		"role": 3
+	}`
+
+	expected := ProductTypeGroup{
+		Id:          Int(4),
+		ProductType: Int(7),
+		Group:       Int(11),
+		Role:        Ptr(RoleMaintainer),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("Expected POST request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/product_type_groups/") {
+			t.Errorf("Expected /product_type_groups/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.ProductTypeGroups.Create(context.Background(), &ProductTypeGroup{
+		ProductType: Int(7),
+		Group:       Int(11),
+		Role:        Ptr(RoleMaintainer),
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestProductTypeGroupsService_Update(t *testing.T) {
+	response := `{
+		"id": 4,
+		"product_type": 7,
+		"group": 11,
+		"role": 3
+	}`
+
+	expected := ProductTypeGroup{
+		Id:          Int(4),
+		ProductType: Int(7),
+		Group:       Int(11),
+		Role:        Ptr(RoleMaintainer),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Errorf("Expected PUT request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/product_type_groups/4/") {
+			t.Errorf("Expected /product_type_groups/4/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.ProductTypeGroups.Update(context.Background(), 4, &ProductTypeGroup{
+		ProductType: Int(7),
+		Group:       Int(11),
+		Role:        Ptr(RoleMaintainer),
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestProductTypeGroupsService_PartialUpdate(t *testing.T) {
+	response := `{
+		"id": 4,
+		"product_type": 7,
+		"group": 11,
+		"role": 3
+	}`
+
+	expected := ProductTypeGroup{
+		Id:          Int(4),
+		ProductType: Int(7),
+		Group:       Int(11),
+		Role:        Ptr(RoleMaintainer),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch {
+			t.Errorf("Expected PATCH request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/product_type_groups/4/") {
+			t.Errorf("Expected /product_type_groups/4/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.ProductTypeGroups.PartialUpdate(context.Background(), 4, &ProductTypeGroup{
+		ProductType: Int(7),
+		Group:       Int(11),
+		Role:        Ptr(RoleMaintainer),
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestProductTypeGroupsService_Delete(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("Expected DELETE request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/product_type_groups/4/") {
+			t.Errorf("Expected /product_type_groups/4/ in path, got %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	_, err := dj.ProductTypeGroups.Delete(context.Background(), 4)
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+}
+
+func TestProductTypeGroupsOptions_ToString(t *testing.T) {
+	tests := []struct {
+		name     string
+		options  *ProductTypeGroupsOptions
+		expected string
+	}{
+		{
+			name: "product type only",
+			options: &ProductTypeGroupsOptions{
+				ProductType: 7,
+			},
+			expected: "?product_type_id=7",
+		},
+		{
+			name: "all fields",
+			options: &ProductTypeGroupsOptions{
+				Limit:       10,
+				ProductType: 7,
+				Group:       11,
+				Role:        3,
+			},
+			expected: "?group_id=11&limit=10&product_type_id=7&role_id=3",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := tt.options.ToString()
+			if actual != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, actual)
+			}
+		})
+	}
+}
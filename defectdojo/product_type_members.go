@@ -0,0 +1,195 @@
+package defectdojo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+type ProductTypeMembersService struct {
+	client *Client
+}
+
+type ProductTypeMember struct {
+	Id          *int    `json:"id,omitempty"`
+	ProductType *int    `json:"product_type,omitempty"`
+	User        *int    `json:"user,omitempty"`
+	Role        *RoleID `json:"role,omitempty"`
+}
+
+type ProductTypeMembers struct {
+	Count    *int                 `json:"count,omitempty"`
+	Next     *string              `json:"next,omitempty"`
+	Previous *string              `json:"previous,omitempty"`
+	Results  *[]ProductTypeMember `json:"results,omitempty"`
+}
+
+type ProductTypeMembersOptions struct {
+	Limit       int
+	Offset      int
+	ID          int
+	ProductType int
+	User        int
+	Role        RoleID
+}
+
+func (o *ProductTypeMembersOptions) ToString() string {
+	if o == nil {
+		return ""
+	}
+
+	v := url.Values{}
+	if o.Limit > 0 {
+		v.Set("limit", strconv.Itoa(o.Limit))
+	}
+	if o.Offset > 0 {
+		v.Set("offset", strconv.Itoa(o.Offset))
+	}
+	if o.ID > 0 {
+		v.Set("id", strconv.Itoa(o.ID))
+	}
+	if o.ProductType > 0 {
+		v.Set("product_type_id", strconv.Itoa(o.ProductType))
+	}
+	if o.User > 0 {
+		v.Set("user_id", strconv.Itoa(o.User))
+	}
+	if o.Role > 0 {
+		v.Set("role_id", strconv.Itoa(int(o.Role)))
+	}
+
+	return "?" + v.Encode()
+}
+
+func (c *ProductTypeMembersService) List(ctx context.Context, options *ProductTypeMembersOptions) (*ProductTypeMembers, error) {
+	path := fmt.Sprintf("%s/product_type_members/%s", c.client.BaseURL, options.ToString())
+
+	req, err := http.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := ProductTypeMembers{}
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// All returns an iterator over all product type members matching the given options,
+// fetching further pages from DefectDojo as the iteration advances.
+func (c *ProductTypeMembersService) All(ctx context.Context, options *ProductTypeMembersOptions) iter.Seq2[ProductTypeMember, error] {
+	path := fmt.Sprintf("%s/product_type_members/%s", c.client.BaseURL, options.ToString())
+
+	return all[ProductTypeMember](ctx, c.client, path)
+}
+
+func (c *ProductTypeMembersService) Read(ctx context.Context, id int) (*ProductTypeMember, error) {
+	path := fmt.Sprintf("%s/product_type_members/%d/", c.client.BaseURL, id)
+
+	req, err := http.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(ProductTypeMember)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *ProductTypeMembersService) Create(ctx context.Context, u *ProductTypeMember) (*ProductTypeMember, error) {
+	path := fmt.Sprintf("%s/product_type_members/", c.client.BaseURL)
+
+	postJSON, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPost, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(ProductTypeMember)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *ProductTypeMembersService) Update(ctx context.Context, id int, u *ProductTypeMember) (*ProductTypeMember, error) {
+	path := fmt.Sprintf("%s/product_type_members/%d/", c.client.BaseURL, id)
+
+	postJSON, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPut, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(ProductTypeMember)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *ProductTypeMembersService) PartialUpdate(ctx context.Context, id int, u *ProductTypeMember) (*ProductTypeMember, error) {
+	path := fmt.Sprintf("%s/product_type_members/%d/", c.client.BaseURL, id)
+
+	postJSON, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPatch, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(ProductTypeMember)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *ProductTypeMembersService) Delete(ctx context.Context, id int) (*ProductTypeMember, error) {
+	path := fmt.Sprintf("%s/product_type_members/%d/", c.client.BaseURL, id)
+
+	req, err := http.NewRequest(http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(ProductTypeMember)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
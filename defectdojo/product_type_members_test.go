@@ -0,0 +1,285 @@
+package defectdojo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestProductTypeMembersService_List(t *testing.T) {
+	response := `{
+		"count": 1,
+		"next": null,
+		"previous": null,
+		"results": [
+			{
+				"id": 4,
+				"product_type": 7,
+				"user": 11,
+				"role": 3
+			}
+		]
+	}`
+
+	expected := ProductTypeMembers{
+		Count:    Int(1),
+		Next:     nil,
+		Previous: nil,
+		Results: &[]ProductTypeMember{
+			{
+				Id:          Int(4),
+				ProductType: Int(7),
+				User:        Int(11),
+				Role:        Ptr(RoleMaintainer),
+			},
+		},
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected GET request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/product_type_members/") {
+			t.Errorf("Expected /product_type_members/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.ProductTypeMembers.List(context.Background(), &ProductTypeMembersOptions{
+		Limit: 10,
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestProductTypeMembersService_Read(t *testing.T) {
+	response := `{
+		"id": 4,
+		"product_type": 7,
+		"user": 11,
+		"role": 3
+	}`
+
+	expected := ProductTypeMember{
+		Id:          Int(4),
+		ProductType: Int(7),
+		User:        Int(11),
+		Role:        Ptr(RoleMaintainer),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected GET request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/product_type_members/4/") {
+			t.Errorf("Expected /product_type_members/4/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.ProductTypeMembers.Read(context.Background(), 4)
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestProductTypeMembersService_Create(t *testing.T) {
+	response := `{
+		"id": 4,
+		"product_type": 7,
+		"user": 11,
+		"role": 3
+	}`
+
+	expected := ProductTypeMember{
+		Id:          Int(4),
+		ProductType: Int(7),
+		User:        Int(11),
+		Role:        Ptr(RoleMaintainer),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("Expected POST request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/product_type_members/") {
+			t.Errorf("Expected /product_type_members/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.ProductTypeMembers.Create(context.Background(), &ProductTypeMember{
+		ProductType: Int(7),
+		User:        Int(11),
+		Role:        Ptr(RoleMaintainer),
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestProductTypeMembersService_Update(t *testing.T) {
+	response := `{
+		"id": 4,
+		"product_type": 7,
+		"user": 11,
+		"role": 3
+	}`
+
+	expected := ProductTypeMember{
+		Id:          Int(4),
+		ProductType: Int(7),
+		User:        Int(11),
+		Role:        Ptr(RoleMaintainer),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Errorf("Expected PUT request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/product_type_members/4/") {
+			t.Errorf("Expected /product_type_members/4/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.ProductTypeMembers.Update(context.Background(), 4, &ProductTypeMember{
+		ProductType: Int(7),
+		User:        Int(11),
+		Role:        Ptr(RoleMaintainer),
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestProductTypeMembersService_PartialUpdate(t *testing.T) {
+	response := `{
+		"id": 4,
+		"product_type": 7,
+		"user": 11,
+		"role": 3
+	}`
+
+	expected := ProductTypeMember{
+		Id:          Int(4),
+		ProductType: Int(7),
+		User:        Int(11),
+		Role:        Ptr(RoleMaintainer),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch {
+			t.Errorf("Expected PATCH request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/product_type_members/4/") {
+			t.Errorf("Expected /product_type_members/4/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.ProductTypeMembers.PartialUpdate(context.Background(), 4, &ProductTypeMember{
+		ProductType: Int(7),
+		User:        Int(11),
+		Role:        Ptr(RoleMaintainer),
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestProductTypeMembersService_Delete(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("Expected DELETE request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/product_type_members/4/") {
+			t.Errorf("Expected /product_type_members/4/ in path, got %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	_, err := dj.ProductTypeMembers.Delete(context.Background(), 4)
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+}
+
+func TestProductTypeMembersOptions_ToString(t *testing.T) {
+	tests := []struct {
+		name     string
+		options  *ProductTypeMembersOptions
+		expected string
+	}{
+		{
+			name: "product type only",
+			options: &ProductTypeMembersOptions{
+				ProductType: 7,
+			},
+			expected: "?product_type_id=7",
+		},
+		{
+			name: "all fields",
+			options: &ProductTypeMembersOptions{
+				Limit:       10,
+				ProductType: 7,
+				User:        11,
+				Role:        3,
+			},
+			expected: "?limit=10&product_type_id=7&role_id=3&user_id=11",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := tt.options.ToString()
+			if actual != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, actual)
+			}
+		})
+	}
+}
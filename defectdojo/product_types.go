@@ -7,6 +7,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/http"
 	"strings"
 	"time"
@@ -143,6 +144,14 @@ func (c *ProductTypesService) List(ctx context.Context, options *ProductTypesOpt
 	return &res, nil
 }
 
+// All returns an iterator over all product types matching the given options,
+// fetching further pages from DefectDojo as the iteration advances.
+func (c *ProductTypesService) All(ctx context.Context, options *ProductTypesOptions) iter.Seq2[ProductType, error] {
+	path := fmt.Sprintf("%s/product_types/%s", c.client.BaseURL, options.ToString())
+
+	return all[ProductType](ctx, c.client, path)
+}
+
 // Read retrieves a single product type by its ID from DefectDojo.
 // It returns the complete product type information including all fields and relationships.
 func (c *ProductTypesService) Read(ctx context.Context, id int) (*ProductType, error) {
@@ -7,13 +7,16 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/http"
+	"net/url"
+	"strconv"
 	"strings"
 	"time"
 )
 
 // ProductsService handles communication with the products related methods of the DefectDojo API.
-// It provides methods to list, read, create, and delete products in DefectDojo.
+// It provides methods to list, read, create, update, and delete products in DefectDojo.
 type ProductsService struct {
 	client *Client
 }
@@ -45,7 +48,7 @@ type Product struct {
 	// ProdNumericGrade is the numerical grade assigned to the product
 	ProdNumericGrade *int `json:"prod_numeric_grade,omitempty"`
 	// BusinessCriticality indicates the importance of the product to the business
-	BusinessCriticality *string `json:"business_criticality,omitempty"`
+	BusinessCriticality *BusinessCriticality `json:"business_criticality,omitempty"`
 	// Platform specifies the technology platform the product runs on
 	Platform *string `json:"platform,omitempty"`
 	// Lifecycle indicates the current stage of the product lifecycle
@@ -78,6 +81,8 @@ type Product struct {
 	AuthorizationGroups *[]int `json:"authorization_groups,omitempty"`
 	// Regulations contains the IDs of regulations that apply to this product
 	Regulations *[]int `json:"regulations,omitempty"`
+	// SlaConfiguration is the ID of the SLA configuration that sets the deadlines of the product's findings
+	SlaConfiguration *int `json:"sla_configuration,omitempty"`
 }
 
 // Products represents a paginated response containing multiple products from the DefectDojo API.
@@ -98,6 +103,8 @@ type Products struct {
 		Members *map[string]User `json:"members,omitempty"`
 		// ProdType maps product type IDs to their full product type objects
 		ProdType *map[string]ProductType `json:"prod_type,omitempty"`
+		// Regulations maps regulation IDs to their full regulation objects
+		Regulations *map[string]Regulation `json:"regulations,omitempty"`
 		// ProductManager maps user IDs to their full user objects for product managers
 		ProductManager *map[string]User `json:"product_manager,omitempty"`
 		// TeamManager maps user IDs to their full user objects for team managers
@@ -113,8 +120,12 @@ type ProductsOptions struct {
 	Limit int
 	// Offset specifies the starting position for pagination
 	Offset int
+	// ID filters products by their ID
+	ID int
 	// Name filters products by name (partial match)
 	Name string
+	// ProdType filters products by the ID of their product type
+	ProdType int
 	// Prefetch specifies related objects to include in the response to reduce API calls
 	Prefetch string
 }
@@ -133,9 +144,15 @@ func (o *ProductsOptions) ToString() string {
 		if o.Offset > 0 {
 			opts = append(opts, fmt.Sprintf("offset=%d", o.Offset))
 		}
+		if o.ID > 0 {
+			opts = append(opts, fmt.Sprintf("id=%d", o.ID))
+		}
 		if len(o.Name) > 0 {
 			opts = append(opts, fmt.Sprintf("name=%s", o.Name))
 		}
+		if o.ProdType > 0 {
+			opts = append(opts, fmt.Sprintf("prod_type=%d", o.ProdType))
+		}
 		if len(o.Prefetch) > 0 {
 			opts = append(opts, fmt.Sprintf("prefetch=%s", o.Prefetch))
 		}
@@ -165,6 +182,14 @@ func (c *ProductsService) List(ctx context.Context, options *ProductsOptions) (*
 	return &res, nil
 }
 
+// All returns an iterator over all products matching the given options,
+// fetching further pages from DefectDojo as the iteration advances.
+func (c *ProductsService) All(ctx context.Context, options *ProductsOptions) iter.Seq2[Product, error] {
+	path := fmt.Sprintf("%s/products/%s", c.client.BaseURL, options.ToString())
+
+	return all[Product](ctx, c.client, path)
+}
+
 // Read retrieves a single product by its ID from DefectDojo.
 // It returns the complete product information including all fields and relationships.
 func (c *ProductsService) Read(ctx context.Context, id int) (*Product, error) {
@@ -210,6 +235,57 @@ func (c *ProductsService) Create(ctx context.Context, u *Product) (*Product, err
 	return res, nil
 }
 
+// Update performs a full update of a product in DefectDojo.
+// It replaces all fields of the product with the provided values.
+// Fields not specified in the Product struct will be set to their zero values.
+func (c *ProductsService) Update(ctx context.Context, id int, u *Product) (*Product, error) {
+	path := fmt.Sprintf("%s/products/%d/", c.client.BaseURL, id)
+
+	postJSON, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPut, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(Product)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// PartialUpdate performs a partial update of a product in DefectDojo.
+// It only updates the fields that are specified in the Product struct,
+// such as BusinessCriticality, Tags or ProductMeta.
+// Fields not specified will remain unchanged on the server.
+func (c *ProductsService) PartialUpdate(ctx context.Context, id int, u *Product) (*Product, error) {
+	path := fmt.Sprintf("%s/products/%d/", c.client.BaseURL, id)
+
+	postJSON, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPatch, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(Product)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
 // Delete removes a product from DefectDojo by its ID.
 // It returns the deleted product information. Note that deleting a product
 // may also delete associated engagements, tests, and findings.
@@ -230,3 +306,178 @@ func (c *ProductsService) Delete(ctx context.Context, id int) (*Product, error)
 
 	return res, nil
 }
+
+// ResolveRegulations returns the full regulation objects of a product of the page,
+// looked up in the regulations prefetched by requesting products with Prefetch set to "regulations".
+// It returns an error if one of the regulations of the product was not prefetched.
+func (p *Products) ResolveRegulations(product *Product) ([]Regulation, error) {
+	if product.Regulations == nil {
+		return nil, nil
+	}
+
+	var prefetched map[string]Regulation
+	if p.Prefetch != nil && p.Prefetch.Regulations != nil {
+		prefetched = *p.Prefetch.Regulations
+	}
+
+	res := make([]Regulation, 0, len(*product.Regulations))
+	for _, id := range *product.Regulations {
+		r, ok := prefetched[strconv.Itoa(id)]
+		if !ok {
+			return nil, fmt.Errorf("regulation %d was not prefetched", id)
+		}
+		res = append(res, r)
+	}
+
+	return res, nil
+}
+
+// ReportGenerateOption contains the sections to include in a generated report.
+type ReportGenerateOption struct {
+	// IncludeFindingNotes adds the notes of each finding to the report
+	IncludeFindingNotes *bool `json:"include_finding_notes,omitempty"`
+	// IncludeFindingImages adds the images attached to each finding to the report
+	IncludeFindingImages *bool `json:"include_finding_images,omitempty"`
+	// IncludeExecutiveSummary adds an executive summary to the report
+	IncludeExecutiveSummary *bool `json:"include_executive_summary,omitempty"`
+	// IncludeTableOfContents adds a table of contents to the report
+	IncludeTableOfContents *bool `json:"include_table_of_contents,omitempty"`
+}
+
+// Report represents a report generated by DefectDojo for a product.
+type Report struct {
+	// ExecutiveSummary summarizes the engagements and tests covered by the report
+	ExecutiveSummary *struct {
+		EngagementName        *string `json:"engagement_name,omitempty"`
+		EngagementTargetStart *string `json:"engagement_target_start,omitempty"`
+		EngagementTargetEnd   *string `json:"engagement_target_end,omitempty"`
+		TestTypeName          *string `json:"test_type_name,omitempty"`
+		TestTargetStart       *string `json:"test_target_start,omitempty"`
+		TestTargetEnd         *string `json:"test_target_end,omitempty"`
+		TestEnvironmentName   *string `json:"test_environment_name,omitempty"`
+		TestStrategyRef       *string `json:"test_strategy_ref,omitempty"`
+		TotalFindings         *int    `json:"total_findings,omitempty"`
+	} `json:"executive_summary,omitempty"`
+	// ProductType is the product type of the reported product
+	ProductType *ProductType `json:"product_type,omitempty"`
+	// Product is the reported product
+	Product *Product `json:"product,omitempty"`
+	// ReportName is the name of the report
+	ReportName *string `json:"report_name,omitempty"`
+	// ReportInfo describes when and by whom the report was generated
+	ReportInfo *string `json:"report_info,omitempty"`
+	// Title is the title of the report
+	Title *string `json:"title,omitempty"`
+	// TeamName is the name of the team the report was generated for
+	TeamName *string `json:"team_name,omitempty"`
+	// UserId is the ID of the user who generated the report
+	UserId *int `json:"user_id,omitempty"`
+	// Host is the URL of the DefectDojo instance that generated the report
+	Host *string `json:"host,omitempty"`
+	// Findings contains the findings covered by the report
+	Findings *[]Finding `json:"findings,omitempty"`
+	// FindingNotes contains the notes of each finding, if requested
+	FindingNotes *[]FindingNotes `json:"finding_notes,omitempty"`
+}
+
+// DeletePreview represents an object that would be deleted along with its parent.
+type DeletePreview struct {
+	// Model is the kind of the object, such as "Engagement" or "Finding"
+	Model *string `json:"model,omitempty"`
+	// Id is the unique identifier of the object
+	Id *int `json:"id,omitempty"`
+	// Name is the human-readable name of the object
+	Name *string `json:"name,omitempty"`
+}
+
+// DeletePreviews represents a paginated list of the objects a delete would cascade into.
+type DeletePreviews struct {
+	// Count is the total number of objects that would be deleted
+	Count *int `json:"count,omitempty"`
+	// Next is the URL for the next page of results
+	Next *string `json:"next,omitempty"`
+	// Previous is the URL for the previous page of results
+	Previous *string `json:"previous,omitempty"`
+	// Results contains the objects for the current page
+	Results *[]DeletePreview `json:"results,omitempty"`
+}
+
+// GenerateReport generates a report of the product with the sections selected in options.
+func (c *ProductsService) GenerateReport(ctx context.Context, id int, options *ReportGenerateOption) (*Report, error) {
+	path := fmt.Sprintf("%s/products/%d/generate_report/", c.client.BaseURL, id)
+
+	if options == nil {
+		options = &ReportGenerateOption{}
+	}
+
+	postJSON, err := json.Marshal(options)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPost, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(Report)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// DeletePreviewOptions contains optional parameters for paginating a delete preview.
+type DeletePreviewOptions struct {
+	// Limit specifies the maximum number of objects to return (default: 20)
+	Limit int
+	// Offset specifies the starting position for pagination
+	Offset int
+}
+
+// ToString converts DeletePreviewOptions to a URL query string for API requests.
+func (o *DeletePreviewOptions) ToString() string {
+	if o == nil {
+		return ""
+	}
+
+	v := url.Values{}
+	if o.Limit > 0 {
+		v.Set("limit", strconv.Itoa(o.Limit))
+	}
+	if o.Offset > 0 {
+		v.Set("offset", strconv.Itoa(o.Offset))
+	}
+
+	return "?" + v.Encode()
+}
+
+// DeletePreview lists the objects that would be deleted along with the product,
+// so the effect of Delete can be checked before running it.
+func (c *ProductsService) DeletePreview(ctx context.Context, id int, options *DeletePreviewOptions) (*DeletePreviews, error) {
+	path := fmt.Sprintf("%s/products/%d/delete_preview/%s", c.client.BaseURL, id, options.ToString())
+
+	req, err := http.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := DeletePreviews{}
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// AllDeletePreview returns an iterator over all the objects that would be deleted along with the product,
+// fetching further pages from DefectDojo as the iteration advances.
+func (c *ProductsService) AllDeletePreview(ctx context.Context, id int, options *DeletePreviewOptions) iter.Seq2[DeletePreview, error] {
+	path := fmt.Sprintf("%s/products/%d/delete_preview/%s", c.client.BaseURL, id, options.ToString())
+
+	return all[DeletePreview](ctx, c.client, path)
+}
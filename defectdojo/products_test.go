@@ -3,6 +3,7 @@ package defectdojo
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -93,7 +94,7 @@ func TestProductsService_List(t *testing.T) {
 				Description:                Str("A test product"),
 				Created:                    Date(time.Date(2022, 1, 1, 12, 0, 0, 0, time.UTC)),
 				ProdNumericGrade:           Int(95),
-				BusinessCriticality:        Str("high"),
+				BusinessCriticality:        Ptr(BusinessCriticalityHigh),
 				Platform:                   Str("web"),
 				Lifecycle:                  Str("production"),
 				Origin:                     Str("internal"),
@@ -129,7 +130,7 @@ func TestProductsService_List(t *testing.T) {
 				Description:                Str("Another test product"),
 				Created:                    Date(time.Date(2022, 1, 2, 12, 0, 0, 0, time.UTC)),
 				ProdNumericGrade:           Int(87),
-				BusinessCriticality:        Str("medium"),
+				BusinessCriticality:        Ptr(BusinessCriticalityMedium),
 				Platform:                   Str("mobile"),
 				Lifecycle:                  Str("development"),
 				Origin:                     Str("third_party"),
@@ -224,7 +225,7 @@ func TestProductsService_Read(t *testing.T) {
 		Description:                Str("A test product"),
 		Created:                    Date(time.Date(2022, 1, 1, 12, 0, 0, 0, time.UTC)),
 		ProdNumericGrade:           Int(95),
-		BusinessCriticality:        Str("high"),
+		BusinessCriticality:        Ptr(BusinessCriticalityHigh),
 		Platform:                   Str("web"),
 		Lifecycle:                  Str("production"),
 		Origin:                     Str("internal"),
@@ -314,7 +315,7 @@ func TestProductsService_Create(t *testing.T) {
 		Description:                Str("A new product"),
 		Created:                    Date(time.Date(2022, 1, 1, 12, 0, 0, 0, time.UTC)),
 		ProdNumericGrade:           Int(80),
-		BusinessCriticality:        Str("medium"),
+		BusinessCriticality:        Ptr(BusinessCriticalityMedium),
 		Platform:                   Str("web"),
 		Lifecycle:                  Str("development"),
 		Origin:                     Str("internal"),
@@ -357,7 +358,7 @@ func TestProductsService_Create(t *testing.T) {
 	actual, err := dj.Products.Create(context.Background(), &Product{
 		Name:                Str("New Product"),
 		Description:         Str("A new product"),
-		BusinessCriticality: Str("medium"),
+		BusinessCriticality: Ptr(BusinessCriticalityMedium),
 		Platform:            Str("web"),
 		Lifecycle:           Str("development"),
 		ProdType:            Int(1),
@@ -396,6 +397,233 @@ func TestProductsService_Delete(t *testing.T) {
 	}
 }
 
+func TestProductsService_Update(t *testing.T) {
+	response := `{
+		"id": 789,
+		"name": "Payments API",
+		"business_criticality": "very high",
+		"tags": ["pci"],
+		"product_meta": [{"name": "owner", "value": "payments-team"}],
+		"prod_type": 1
+	}`
+
+	expected := Product{
+		ID:                  Int(789),
+		Name:                Str("Payments API"),
+		BusinessCriticality: Ptr(BusinessCriticalityVeryHigh),
+		Tags:                &[]string{"pci"},
+		ProductMeta: &[]struct {
+			Name  *string `json:"name,omitempty"`
+			Value *string `json:"value,omitempty"`
+		}{
+			{Name: Str("owner"), Value: Str("payments-team")},
+		},
+		ProdType: Int(1),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Errorf("Expected PUT request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/products/789/") {
+			t.Errorf("Expected /products/789/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.Products.Update(context.Background(), 789, &Product{
+		BusinessCriticality: Ptr(BusinessCriticalityVeryHigh),
+		Tags:                &[]string{"pci"},
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestProductsService_PartialUpdate(t *testing.T) {
+	response := `{
+		"id": 789,
+		"name": "Payments API",
+		"business_criticality": "very high",
+		"tags": ["pci"],
+		"product_meta": [{"name": "owner", "value": "payments-team"}],
+		"prod_type": 1
+	}`
+
+	expected := Product{
+		ID:                  Int(789),
+		Name:                Str("Payments API"),
+		BusinessCriticality: Ptr(BusinessCriticalityVeryHigh),
+		Tags:                &[]string{"pci"},
+		ProductMeta: &[]struct {
+			Name  *string `json:"name,omitempty"`
+			Value *string `json:"value,omitempty"`
+		}{
+			{Name: Str("owner"), Value: Str("payments-team")},
+		},
+		ProdType: Int(1),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch {
+			t.Errorf("Expected PATCH request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/products/789/") {
+			t.Errorf("Expected /products/789/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.Products.PartialUpdate(context.Background(), 789, &Product{
+		BusinessCriticality: Ptr(BusinessCriticalityVeryHigh),
+		Tags:                &[]string{"pci"},
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestProductsService_GenerateReport(t *testing.T) {
+	response := `{
+		"report_name": "Product Report",
+		"title": "Payments API",
+		"product": {"id": 789, "name": "Payments API"},
+		"findings": [{"id": 1, "title": "SQL Injection", "severity": "Critical"}],
+		"finding_notes": [{"finding_id": 1, "notes": [{"id": 4, "entry": "Fixed in 2.3"}]}]
+	}`
+
+	expected := Report{
+		ReportName: Str("Product Report"),
+		Title:      Str("Payments API"),
+		Product:    &Product{ID: Int(789), Name: Str("Payments API")},
+		Findings:   &[]Finding{{Id: Int(1), Title: Str("SQL Injection"), Severity: Ptr(SeverityCritical)}},
+		FindingNotes: &[]FindingNotes{
+			{FindingId: Int(1), Notes: &[]Note{{Id: Int(4), Entry: Str("Fixed in 2.3")}}},
+		},
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("Expected POST request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/products/789/generate_report/") {
+			t.Errorf("Expected /products/789/generate_report/ in path, got %s", r.URL.Path)
+		}
+		b, _ := io.ReadAll(r.Body)
+		if string(b) != `{"include_finding_notes":true,"include_executive_summary":false}` {
+			t.Errorf("unexpected body %s", b)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.Products.GenerateReport(context.Background(), 789, &ReportGenerateOption{
+		IncludeFindingNotes:     Bool(true),
+		IncludeExecutiveSummary: Bool(false),
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestProductsService_DeletePreview(t *testing.T) {
+	response := `{
+		"count": 2,
+		"next": null,
+		"previous": null,
+		"results": [
+			{"model": "Engagement", "id": 12, "name": "CI build 42"},
+			{"model": "Finding", "id": 301, "name": "SQL Injection"}
+		]
+	}`
+
+	expected := DeletePreviews{
+		Count: Int(2),
+		Results: &[]DeletePreview{
+			{Model: Str("Engagement"), Id: Int(12), Name: Str("CI build 42")},
+			{Model: Str("Finding"), Id: Int(301), Name: Str("SQL Injection")},
+		},
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected GET request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/products/789/delete_preview/") {
+			t.Errorf("Expected /products/789/delete_preview/ in path, got %s", r.URL.Path)
+		}
+		if r.URL.Query().Get("limit") != "50" {
+			t.Errorf("Expected limit=50, got %s", r.URL.RawQuery)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.Products.DeletePreview(context.Background(), 789, &DeletePreviewOptions{Limit: 50})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestProductsService_AllDeletePreview(t *testing.T) {
+	var ts *httptest.Server
+	ts = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.Contains(r.URL.Path, "/products/789/delete_preview/") {
+			t.Errorf("Expected /products/789/delete_preview/ in path, got %s", r.URL.Path)
+		}
+		switch r.URL.Query().Get("offset") {
+		case "":
+			_, _ = fmt.Fprintf(w, `{"count": 3, "next": "%s/api/v2/products/789/delete_preview/?limit=2&offset=2", "results": [{"model": "Engagement", "id": 12}, {"model": "Test", "id": 40}]}`, ts.URL)
+		case "2":
+			_, _ = fmt.Fprintln(w, `{"count": 3, "next": null, "results": [{"model": "Finding", "id": 301}]}`)
+		default:
+			t.Errorf("unexpected offset %s", r.URL.Query().Get("offset"))
+		}
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	var models []string
+	for p, err := range dj.Products.AllDeletePreview(context.Background(), 789, &DeletePreviewOptions{Limit: 2}) {
+		if !cmp.Equal(err, nil) {
+			t.Fatalf("error: %s", err)
+		}
+		models = append(models, *p.Model)
+	}
+
+	expected := []string{"Engagement", "Test", "Finding"}
+	if !cmp.Equal(models, expected) {
+		t.Errorf("should have been equal, %v, %v", models, expected)
+	}
+}
+
 func TestProductsOptions_ToString(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -433,6 +661,20 @@ func TestProductsOptions_ToString(t *testing.T) {
 			},
 			expected: "?name=test",
 		},
+		{
+			name: "id only",
+			options: &ProductsOptions{
+				ID: 7,
+			},
+			expected: "?id=7",
+		},
+		{
+			name: "product type only",
+			options: &ProductsOptions{
+				ProdType: 3,
+			},
+			expected: "?prod_type=3",
+		},
 		{
 			name: "prefetch only",
 			options: &ProductsOptions{
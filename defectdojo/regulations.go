@@ -0,0 +1,228 @@
+package defectdojo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+type RegulationsService struct {
+	client *Client
+}
+
+type Regulation struct {
+	Id           *int                `json:"id,omitempty"`
+	Name         *string             `json:"name,omitempty"`
+	Acronym      *string             `json:"acronym,omitempty"`
+	Category     *RegulationCategory `json:"category,omitempty"`
+	Jurisdiction *string             `json:"jurisdiction,omitempty"`
+	Description  *string             `json:"description,omitempty"`
+	Reference    *string             `json:"reference,omitempty"`
+}
+
+type Regulations struct {
+	Count    *int          `json:"count,omitempty"`
+	Next     *string       `json:"next,omitempty"`
+	Previous *string       `json:"previous,omitempty"`
+	Results  *[]Regulation `json:"results,omitempty"`
+}
+
+type RegulationsOptions struct {
+	Limit       int
+	Offset      int
+	ID          int
+	Name        string
+	Description string
+}
+
+func (o *RegulationsOptions) ToString() string {
+	if o == nil {
+		return ""
+	}
+
+	v := url.Values{}
+	if o.Limit > 0 {
+		v.Set("limit", strconv.Itoa(o.Limit))
+	}
+	if o.Offset > 0 {
+		v.Set("offset", strconv.Itoa(o.Offset))
+	}
+	if o.ID > 0 {
+		v.Set("id", strconv.Itoa(o.ID))
+	}
+	if len(o.Name) > 0 {
+		v.Set("name", o.Name)
+	}
+	if len(o.Description) > 0 {
+		v.Set("description", o.Description)
+	}
+
+	return "?" + v.Encode()
+}
+
+func (c *RegulationsService) List(ctx context.Context, options *RegulationsOptions) (*Regulations, error) {
+	path := fmt.Sprintf("%s/regulations/%s", c.client.BaseURL, options.ToString())
+
+	req, err := http.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := Regulations{}
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// All returns an iterator over all regulations matching the given options,
+// fetching further pages from DefectDojo as the iteration advances.
+func (c *RegulationsService) All(ctx context.Context, options *RegulationsOptions) iter.Seq2[Regulation, error] {
+	path := fmt.Sprintf("%s/regulations/%s", c.client.BaseURL, options.ToString())
+
+	return all[Regulation](ctx, c.client, path)
+}
+
+func (c *RegulationsService) Read(ctx context.Context, id int) (*Regulation, error) {
+	path := fmt.Sprintf("%s/regulations/%d/", c.client.BaseURL, id)
+
+	req, err := http.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(Regulation)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *RegulationsService) Create(ctx context.Context, u *Regulation) (*Regulation, error) {
+	path := fmt.Sprintf("%s/regulations/", c.client.BaseURL)
+
+	postJSON, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPost, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(Regulation)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *RegulationsService) Update(ctx context.Context, id int, u *Regulation) (*Regulation, error) {
+	path := fmt.Sprintf("%s/regulations/%d/", c.client.BaseURL, id)
+
+	postJSON, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPut, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(Regulation)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *RegulationsService) PartialUpdate(ctx context.Context, id int, u *Regulation) (*Regulation, error) {
+	path := fmt.Sprintf("%s/regulations/%d/", c.client.BaseURL, id)
+
+	postJSON, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPatch, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(Regulation)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *RegulationsService) Delete(ctx context.Context, id int) (*Regulation, error) {
+	path := fmt.Sprintf("%s/regulations/%d/", c.client.BaseURL, id)
+
+	req, err := http.NewRequest(http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(Regulation)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// ForProduct returns the regulations that apply to the product, fetched along with it
+// through the regulations prefetch.
+func (c *RegulationsService) ForProduct(ctx context.Context, productID int) ([]Regulation, error) {
+	if productID <= 0 {
+		return nil, fmt.Errorf("ForProduct: invalid product %d", productID)
+	}
+
+	path := fmt.Sprintf("%s/products/%d/?prefetch=regulations", c.client.BaseURL, productID)
+
+	req, err := http.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	var res json.RawMessage
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	// the product and its "prefetch" object share the body, which is decoded once for each
+	var product Product
+	if err := json.Unmarshal(res, &product); err != nil {
+		return nil, err
+	}
+	var page Products
+	if err := json.Unmarshal(res, &page); err != nil {
+		return nil, err
+	}
+
+	return page.ResolveRegulations(&product)
+}
@@ -0,0 +1,377 @@
+package defectdojo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRegulationsService_List(t *testing.T) {
+	response := `{
+		"count": 1,
+		"next": null,
+		"previous": null,
+		"results": [
+			{
+				"id": 1,
+				"name": "Payment Card Industry Data Security Standard",
+				"acronym": "PCI DSS",
+				"category": "finance",
+				"jurisdiction": "Worldwide",
+				"reference": "https://www.pcisecuritystandards.org/"
+			}
+		]
+	}`
+
+	expected := Regulations{
+		Count:    Int(1),
+		Next:     nil,
+		Previous: nil,
+		Results: &[]Regulation{
+			{
+				Id:           Int(1),
+				Name:         Str("Payment Card Industry Data Security Standard"),
+				Acronym:      Str("PCI DSS"),
+				Category:     Ptr(RegulationCategoryFinance),
+				Jurisdiction: Str("Worldwide"),
+				Reference:    Str("https://www.pcisecuritystandards.org/"),
+			},
+		},
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected GET request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/regulations/") {
+			t.Errorf("Expected /regulations/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.Regulations.List(context.Background(), &RegulationsOptions{
+		Limit: 10,
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestRegulationsService_Read(t *testing.T) {
+	response := `{
+		"id": 1,
+		"name": "Payment Card Industry Data Security Standard",
+		"acronym": "PCI DSS",
+		"category": "finance",
+		"jurisdiction": "Worldwide",
+		"reference": "https://www.pcisecuritystandards.org/"
+	}`
+
+	expected := Regulation{
+		Id:           Int(1),
+		Name:         Str("Payment Card Industry Data Security Standard"),
+		Acronym:      Str("PCI DSS"),
+		Category:     Ptr(RegulationCategoryFinance),
+		Jurisdiction: Str("Worldwide"),
+		Reference:    Str("https://www.pcisecuritystandards.org/"),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected GET request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/regulations/1/") {
+			t.Errorf("Expected /regulations/1/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.Regulations.Read(context.Background(), 1)
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestRegulationsService_Create(t *testing.T) {
+	response := `{
+		"id": 1,
+		"name": "Payment Card Industry Data Security Standard",
+		"acronym": "PCI DSS",
+		"category": "finance",
+		"jurisdiction": "Worldwide",
+		"reference": "https://www.pcisecuritystandards.org/"
+	}`
+
+	expected := Regulation{
+		Id:           Int(1),
+		Name:         Str("Payment Card Industry Data Security Standard"),
+		Acronym:      Str("PCI DSS"),
+		Category:     Ptr(RegulationCategoryFinance),
+		Jurisdiction: Str("Worldwide"),
+		Reference:    Str("https://www.pcisecuritystandards.org/"),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("Expected POST request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/regulations/") {
+			t.Errorf("Expected /regulations/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.Regulations.Create(context.Background(), &Regulation{
+		Name:     Str("Payment Card Industry Data Security Standard"),
+		Acronym:  Str("PCI DSS"),
+		Category: Ptr(RegulationCategoryFinance),
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestRegulationsService_Update(t *testing.T) {
+	response := `{
+		"id": 1,
+		"name": "Payment Card Industry Data Security Standard",
+		"acronym": "PCI DSS",
+		"category": "finance",
+		"jurisdiction": "Worldwide",
+		"reference": "https://www.pcisecuritystandards.org/"
+	}`
+
+	expected := Regulation{
+		Id:           Int(1),
+		Name:         Str("Payment Card Industry Data Security Standard"),
+		Acronym:      Str("PCI DSS"),
+		Category:     Ptr(RegulationCategoryFinance),
+		Jurisdiction: Str("Worldwide"),
+		Reference:    Str("https://www.pcisecuritystandards.org/"),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Errorf("Expected PUT request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/regulations/1/") {
+			t.Errorf("Expected /regulations/1/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.Regulations.Update(context.Background(), 1, &Regulation{
+		Name:     Str("Payment Card Industry Data Security Standard"),
+		Acronym:  Str("PCI DSS"),
+		Category: Ptr(RegulationCategoryFinance),
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestRegulationsService_PartialUpdate(t *testing.T) {
+	response := `{
+		"id": 1,
+		"name": "Payment Card Industry Data Security Standard",
+		"acronym": "PCI DSS",
+		"category": "finance",
+		"jurisdiction": "Worldwide",
+		"reference": "https://www.pcisecuritystandards.org/"
+	}`
+
+	expected := Regulation{
+		Id:           Int(1),
+		Name:         Str("Payment Card Industry Data Security Standard"),
+		Acronym:      Str("PCI DSS"),
+		Category:     Ptr(RegulationCategoryFinance),
+		Jurisdiction: Str("Worldwide"),
+		Reference:    Str("https://www.pcisecuritystandards.org/"),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch {
+			t.Errorf("Expected PATCH request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/regulations/1/") {
+			t.Errorf("Expected /regulations/1/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.Regulations.PartialUpdate(context.Background(), 1, &Regulation{
+		Name:     Str("Payment Card Industry Data Security Standard"),
+		Acronym:  Str("PCI DSS"),
+		Category: Ptr(RegulationCategoryFinance),
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestRegulationsService_Delete(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("Expected DELETE request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/regulations/1/") {
+			t.Errorf("Expected /regulations/1/ in path, got %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	_, err := dj.Regulations.Delete(context.Background(), 1)
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+}
+
+func TestRegulationsOptions_ToString(t *testing.T) {
+	tests := []struct {
+		name     string
+		options  *RegulationsOptions
+		expected string
+	}{
+		{
+			name: "name only",
+			options: &RegulationsOptions{
+				Name: "GDPR",
+			},
+			expected: "?name=GDPR",
+		},
+		{
+			name: "all fields",
+			options: &RegulationsOptions{
+				Limit:       10,
+				ID:          1,
+				Description: "privacy",
+			},
+			expected: "?description=privacy&id=1&limit=10",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := tt.options.ToString()
+			if actual != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestRegulationsService_ForProduct(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v2/products/3/" {
+			t.Errorf("Expected /api/v2/products/3/ path, got %s", r.URL.Path)
+		}
+		if r.URL.RawQuery != "prefetch=regulations" {
+			t.Errorf("Expected prefetch=regulations query, got %s", r.URL.RawQuery)
+		}
+		_, _ = fmt.Fprintln(w, `{
+			"id": 3,
+			"regulations": [2, 1],
+			"prefetch": {"regulations": {
+				"1": {"id": 1, "acronym": "PCI DSS"},
+				"2": {"id": 2, "acronym": "GDPR"}
+			}}
+		}`)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.Regulations.ForProduct(context.Background(), 3)
+	if !cmp.Equal(err, nil) {
+		t.Fatalf("error: %s", err)
+	}
+
+	expected := []Regulation{
+		{Id: Int(2), Acronym: Str("GDPR")},
+		{Id: Int(1), Acronym: Str("PCI DSS")},
+	}
+	if !cmp.Equal(actual, expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, expected)
+	}
+}
+
+func TestRegulationsService_ForProduct_notFound(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = fmt.Fprintln(w, `{"detail": "Not found."}`)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	_, err := dj.Regulations.ForProduct(context.Background(), 404)
+	if !IsNotFound(err) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+}
+
+func TestRegulationsService_ForProduct_invalidID(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected %s request to %s", r.Method, r.URL.String())
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	for _, id := range []int{0, -1} {
+		if _, err := dj.Regulations.ForProduct(context.Background(), id); cmp.Equal(err, nil) {
+			t.Errorf("expected an error for product %d", id)
+		}
+	}
+}
+
+func TestProducts_ResolveRegulations_missingPrefetch(t *testing.T) {
+	page := &Products{}
+
+	if _, err := page.ResolveRegulations(&Product{Regulations: &[]int{1}}); cmp.Equal(err, nil) {
+		t.Errorf("expected an error without prefetched regulations")
+	}
+}
@@ -11,7 +11,7 @@ type ReImportScanService struct {
 
 type ReImportScan struct {
 	ScanDate                     *string   `json:"scan_date,omitempty"`
-	MinimumSeverity              *string   `json:"minimum_severity,omitempty"`
+	MinimumSeverity              *Severity `json:"minimum_severity,omitempty"`
 	Active                       *bool     `json:"active,omitempty"`
 	Verified                     *bool     `json:"verified,omitempty"`
 	ScanType                     *string   `json:"scan_type,omitempty"`
@@ -42,25 +42,69 @@ type ReImportScan struct {
 	EngagementId                 *int      `json:"engagement_id,omitempty"`
 	ProductId                    *int      `json:"product_id,omitempty"`
 	ProductTypeId                *int      `json:"product_type_id,omitempty"`
+	// FileUpload, when set, is streamed as the scan report instead of the file at File
+	FileUpload *FileUpload `json:"-"`
+}
+
+// ReImportScanResult is the response of DefectDojo to a scan reimport.
+type ReImportScanResult struct {
+	ScanDate                     *string           `json:"scan_date,omitempty"`
+	MinimumSeverity              *Severity         `json:"minimum_severity,omitempty"`
+	Active                       *bool             `json:"active,omitempty"`
+	Verified                     *bool             `json:"verified,omitempty"`
+	ScanType                     *string           `json:"scan_type,omitempty"`
+	EndpointToAdd                *int              `json:"endpoint_to_add,omitempty"`
+	File                         *string           `json:"file,omitempty"`
+	ProductTypeName              *string           `json:"product_type_name,omitempty"`
+	ProductName                  *string           `json:"product_name,omitempty"`
+	EngagementName               *string           `json:"engagement_name,omitempty"`
+	EngagementEndDate            *string           `json:"engagement_end_date,omitempty"`
+	SourceCodeManagementUri      *string           `json:"source_code_management_uri,omitempty"`
+	CloseOldFindingsProductScope *bool             `json:"close_old_findings_product_scope,omitempty"`
+	DoNotReactivate              *bool             `json:"do_not_reactivate,omitempty"`
+	TestTitle                    *string           `json:"test_title,omitempty"`
+	AutoCreateContext            *bool             `json:"auto_create_context,omitempty"`
+	DeduplicationOnEngagement    *bool             `json:"deduplication_on_engagement,omitempty"`
+	Lead                         *int              `json:"lead,omitempty"`
+	Tags                         *[]string         `json:"tags,omitempty"`
+	CloseOldFindings             *bool             `json:"close_old_findings,omitempty"`
+	PushToJira                   *bool             `json:"push_to_jira,omitempty"`
+	Environment                  *string           `json:"environment,omitempty"`
+	Version                      *string           `json:"version,omitempty"`
+	BuildId                      *string           `json:"build_id,omitempty"`
+	BranchTag                    *string           `json:"branch_tag,omitempty"`
+	CommitHash                   *string           `json:"commit_hash,omitempty"`
+	ApiScanConfiguration         *int              `json:"api_scan_configuration,omitempty"`
+	Service                      *string           `json:"service,omitempty"`
+	GroupBy                      *string           `json:"group_by,omitempty"`
+	Test                         *int              `json:"test,omitempty"`
+	TestId                       *int              `json:"test_id,omitempty"`
+	EngagementId                 *int              `json:"engagement_id,omitempty"`
+	ProductId                    *int              `json:"product_id,omitempty"`
+	ProductTypeId                *int              `json:"product_type_id,omitempty"`
+	Statistics                   *ImportStatistics `json:"statistics,omitempty"`
 }
 
 type ReimportScanMap map[string]string
 
-func (c *ReImportScanService) Create(ctx context.Context, m *ReImportScan) (*ReImportScan, error) {
+func (c *ReImportScanService) Create(ctx context.Context, m *ReImportScan) (*ReImportScanResult, error) {
 	path := fmt.Sprintf("%s/reimport-scan/", c.client.BaseURL)
 
+	if err := c.client.checkScanType(m.ScanType); err != nil {
+		return nil, err
+	}
 	up, err := structTagToMap(*m)
 	if err != nil {
 		return nil, err
 	}
-	req, err := newFileUploadRequest(path, &up)
+	req, err := newFileUploadRequest(path, &up, m.FileUpload)
 	if err != nil {
 		return nil, err
 	}
 
 	req = req.WithContext(ctx)
 
-	res := new(ReImportScan)
+	res := new(ReImportScanResult)
 	if err := c.client.sendRequest(req, &res); err != nil {
 		return nil, err
 	}
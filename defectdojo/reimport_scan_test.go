@@ -0,0 +1,119 @@
+package defectdojo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestReImportScanService_Create(t *testing.T) {
+	response := `{
+		"scan_type": "Trivy Scan",
+		"test": 12,
+		"test_id": 12,
+		"engagement_id": 3,
+		"product_id": 2,
+		"product_type_id": 1,
+		"scan_date": "2024-05-01",
+		"statistics": {
+			"before": {
+				"high": {"active": 4, "verified": 0, "duplicate": 0, "false_p": 0, "out_of_scope": 0, "is_mitigated": 0, "risk_accepted": 0, "total": 4}
+			},
+			"delta": {
+				"created": {
+					"critical": {"active": 3, "total": 3}
+				},
+				"closed": {
+					"high": {"active": 0, "is_mitigated": 2, "total": 2}
+				},
+				"reactivated": {
+					"total": {"active": 1, "total": 1}
+				},
+				"untouched": {
+					"high": {"active": 2, "total": 2}
+				}
+			},
+			"after": {
+				"total": {"active": 6, "total": 8}
+			}
+		}
+	}`
+
+	expected := ReImportScanResult{
+		ScanType:      Str("Trivy Scan"),
+		Test:          Int(12),
+		TestId:        Int(12),
+		EngagementId:  Int(3),
+		ProductId:     Int(2),
+		ProductTypeId: Int(1),
+		ScanDate:      Str("2024-05-01"),
+		Statistics: &ImportStatistics{
+			Before: &SeverityStatistics{
+				High: &FindingStatistics{
+					Active:       Int(4),
+					Verified:     Int(0),
+					Duplicate:    Int(0),
+					FalseP:       Int(0),
+					OutOfScope:   Int(0),
+					IsMitigated:  Int(0),
+					RiskAccepted: Int(0),
+					Total:        Int(4),
+				},
+			},
+			Delta: &ImportStatisticsDelta{
+				Created: &SeverityStatistics{
+					Critical: &FindingStatistics{Active: Int(3), Total: Int(3)},
+				},
+				Closed: &SeverityStatistics{
+					High: &FindingStatistics{Active: Int(0), IsMitigated: Int(2), Total: Int(2)},
+				},
+				Reactivated: &SeverityStatistics{
+					Total: &FindingStatistics{Active: Int(1), Total: Int(1)},
+				},
+				Untouched: &SeverityStatistics{
+					High: &FindingStatistics{Active: Int(2), Total: Int(2)},
+				},
+			},
+			After: &SeverityStatistics{
+				Total: &FindingStatistics{Active: Int(6), Total: Int(8)},
+			},
+		},
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("Expected POST request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/reimport-scan/") {
+			t.Errorf("Expected /reimport-scan/ in path, got %s", r.URL.Path)
+		}
+		if r.FormValue("test") != "12" {
+			t.Errorf("Expected test=12, got %s", r.FormValue("test"))
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.ReImportScan.Create(context.Background(), &ReImportScan{
+		ScanType: Str("Trivy Scan"),
+		Test:     Int(12),
+		FileUpload: &FileUpload{
+			Name:   "trivy.json",
+			Reader: strings.NewReader(`{"Results": []}`),
+		},
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
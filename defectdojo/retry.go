@@ -0,0 +1,146 @@
+package defectdojo
+
+import (
+	"context"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy controls how the client retries requests that failed with a transient error.
+// A nil policy, the default, sends every request exactly once.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts for a request, including the first one
+	MaxAttempts int
+	// MinBackoff is the delay before the first retry; it doubles with every further attempt
+	MinBackoff time.Duration
+	// MaxBackoff caps the delay computed from MinBackoff. A response asking, through Retry-After,
+	// to wait longer than MaxBackoff is not retried and its error is returned straight away
+	MaxBackoff time.Duration
+	// RetryStatusCodes lists the response status codes that trigger a retry
+	RetryStatusCodes []int
+	// RetryNonIdempotent allows retrying POST and PATCH requests, such as scan imports
+	RetryNonIdempotent bool
+}
+
+// DefaultRetryPolicy returns a policy that makes up to 4 attempts with a backoff
+// between 500ms and 30s, retrying on 429, 502, 503 and 504 responses and on network errors.
+// Only idempotent requests are retried.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: 4,
+		MinBackoff:  500 * time.Millisecond,
+		MaxBackoff:  30 * time.Second,
+		RetryStatusCodes: []int{
+			http.StatusTooManyRequests,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+	}
+}
+
+// shouldRetry reports whether a request that got res and err on the given attempt may be sent again.
+func (p *RetryPolicy) shouldRetry(req *http.Request, res *http.Response, err error, attempt int) bool {
+	if p == nil || attempt >= p.MaxAttempts {
+		return false
+	}
+	if req.Context().Err() != nil {
+		return false
+	}
+	if !p.RetryNonIdempotent && !isIdempotent(req.Method) {
+		return false
+	}
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+	if err != nil {
+		return true
+	}
+	if d, ok := retryAfter(res); ok && p.MaxBackoff > 0 && d > p.MaxBackoff {
+		return false
+	}
+	return slices.Contains(p.RetryStatusCodes, res.StatusCode)
+}
+
+// backoff returns the delay before the next attempt, honouring the Retry-After header of res if any.
+// shouldRetry has already rejected Retry-After delays longer than MaxBackoff.
+func (p *RetryPolicy) backoff(res *http.Response, attempt int) time.Duration {
+	if d, ok := retryAfter(res); ok {
+		return d
+	}
+
+	d := p.MinBackoff << (attempt - 1)
+	if d <= 0 || (p.MaxBackoff > 0 && d > p.MaxBackoff) {
+		d = p.MaxBackoff
+	}
+	if d <= 0 {
+		return 0
+	}
+
+	// equal jitter: half of the delay is fixed, the other half is random
+	return d/2 + rand.N(d/2+1)
+}
+
+func retryAfter(res *http.Response) (time.Duration, bool) {
+	if res == nil {
+		return 0, false
+	}
+	v := res.Header.Get("Retry-After")
+	if len(v) == 0 {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(v); err == nil && secs >= 0 {
+		return time.Duration(secs) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		return max(time.Until(t), 0), true
+	}
+	return 0, false
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// rewind prepares req to be sent again by replacing its consumed body with a fresh copy.
+func rewind(req *http.Request) error {
+	if req.GetBody == nil {
+		return nil
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return err
+	}
+	req.Body = body
+	return nil
+}
+
+// sleep waits for d or until ctx is done, whichever happens first.
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// discard drains and closes the body of a response that will not be used.
+func discard(res *http.Response) {
+	if res == nil {
+		return
+	}
+	_, _ = io.Copy(io.Discard, io.LimitReader(res.Body, 64<<10))
+	_ = res.Body.Close()
+}
@@ -0,0 +1,237 @@
+package defectdojo
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func testRetryPolicy() *RetryPolicy {
+	p := DefaultRetryPolicy()
+	p.MinBackoff = time.Millisecond
+	p.MaxBackoff = 5 * time.Millisecond
+	return p
+}
+
+func TestClient_retry(t *testing.T) {
+	t.Run("retries transient errors", func(t *testing.T) {
+		attempts := 0
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			attempts++
+			if attempts < 3 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			_, _ = fmt.Fprintln(w, `{"id": 1}`)
+		}))
+		defer ts.Close()
+
+		dj, _ := NewDojoClient(ts.URL, "token", nil)
+		dj.RetryPolicy = testRetryPolicy()
+
+		actual, err := dj.Products.Read(context.Background(), 1)
+		if !cmp.Equal(err, nil) {
+			t.Fatalf("error: %s", err)
+		}
+		if *actual.ID != 1 || attempts != 3 {
+			t.Errorf("expected product 1 after 3 attempts, got %d after %d", *actual.ID, attempts)
+		}
+	})
+
+	t.Run("gives up after max attempts", func(t *testing.T) {
+		attempts := 0
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			attempts++
+			w.WriteHeader(http.StatusBadGateway)
+		}))
+		defer ts.Close()
+
+		dj, _ := NewDojoClient(ts.URL, "token", nil)
+		dj.RetryPolicy = testRetryPolicy()
+
+		_, err := dj.Products.Read(context.Background(), 1)
+		if cmp.Equal(err, nil) {
+			t.Fatalf("expected an error")
+		}
+		if attempts != dj.RetryPolicy.MaxAttempts {
+			t.Errorf("expected %d attempts, got %d", dj.RetryPolicy.MaxAttempts, attempts)
+		}
+	})
+
+	t.Run("honours Retry-After", func(t *testing.T) {
+		attempts := 0
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			attempts++
+			if attempts == 1 {
+				w.Header().Set("Retry-After", "0")
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+			_, _ = fmt.Fprintln(w, `{"id": 1}`)
+		}))
+		defer ts.Close()
+
+		dj, _ := NewDojoClient(ts.URL, "token", nil)
+		dj.RetryPolicy = testRetryPolicy()
+		dj.RetryPolicy.MinBackoff = time.Hour
+		dj.RetryPolicy.MaxBackoff = time.Hour
+
+		_, err := dj.Products.Read(context.Background(), 1)
+		if !cmp.Equal(err, nil) {
+			t.Fatalf("error: %s", err)
+		}
+		if attempts != 2 {
+			t.Errorf("expected 2 attempts, got %d", attempts)
+		}
+	})
+
+	t.Run("gives up when Retry-After exceeds MaxBackoff", func(t *testing.T) {
+		attempts := 0
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			attempts++
+			w.Header().Set("Retry-After", "3600")
+			w.WriteHeader(http.StatusTooManyRequests)
+		}))
+		defer ts.Close()
+
+		dj, _ := NewDojoClient(ts.URL, "token", nil)
+		dj.RetryPolicy = testRetryPolicy()
+
+		_, err := dj.Products.Read(context.Background(), 1)
+		if !hasStatus(err, http.StatusTooManyRequests) {
+			t.Fatalf("expected a 429 APIError, got %v", err)
+		}
+		if attempts != 1 {
+			t.Errorf("expected 1 attempt, got %d", attempts)
+		}
+	})
+
+	t.Run("does not retry POST by default", func(t *testing.T) {
+		attempts := 0
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			attempts++
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer ts.Close()
+
+		dj, _ := NewDojoClient(ts.URL, "token", nil)
+		dj.RetryPolicy = testRetryPolicy()
+
+		_, err := dj.Products.Create(context.Background(), &Product{Name: Str("Product")})
+		if cmp.Equal(err, nil) {
+			t.Fatalf("expected an error")
+		}
+		if attempts != 1 {
+			t.Errorf("expected 1 attempt, got %d", attempts)
+		}
+	})
+
+	t.Run("replays multipart uploads when opted in", func(t *testing.T) {
+		file := filepath.Join(t.TempDir(), "report.json")
+		if err := os.WriteFile(file, []byte(`{"results": []}`), 0o600); err != nil {
+			t.Fatal(err)
+		}
+
+		var bodies []string
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if err := r.ParseMultipartForm(1 << 20); err != nil {
+				t.Errorf("cannot parse multipart form: %s", err)
+			}
+			f, _, err := r.FormFile("file")
+			if err != nil {
+				t.Errorf("missing file: %s", err)
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			b, _ := io.ReadAll(f)
+			bodies = append(bodies, string(b))
+			if len(bodies) == 1 {
+				w.WriteHeader(http.StatusBadGateway)
+				return
+			}
+			_, _ = fmt.Fprintln(w, `{"test": 1}`)
+		}))
+		defer ts.Close()
+
+		dj, _ := NewDojoClient(ts.URL, "token", nil)
+		dj.RetryPolicy = testRetryPolicy()
+		dj.RetryPolicy.RetryNonIdempotent = true
+
+		_, err := dj.ImportScan.Create(context.Background(), &ImportScan{
+			ScanType: Str("Trivy Scan"),
+			File:     Str(file),
+		})
+		if !cmp.Equal(err, nil) {
+			t.Fatalf("error: %s", err)
+		}
+		if !cmp.Equal(bodies, []string{`{"results": []}`, `{"results": []}`}) {
+			t.Errorf("expected the file to be uploaded twice, got %v", bodies)
+		}
+	})
+
+	t.Run("replays a seekable upload", func(t *testing.T) {
+		report := bytes.Repeat([]byte(`{"results": []}`), 1<<19)
+
+		attempts := 0
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			attempts++
+			if attempts < 3 {
+				// answer before the upload is read, leaving its writer behind
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			f, _, err := r.FormFile("file")
+			if err != nil {
+				t.Errorf("missing file: %s", err)
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			b, _ := io.ReadAll(f)
+			if !bytes.Equal(b, report) {
+				t.Errorf("expected the whole report to be uploaded, got %d bytes", len(b))
+			}
+			_, _ = fmt.Fprintln(w, `{"test": 1}`)
+		}))
+		defer ts.Close()
+
+		dj, _ := NewDojoClient(ts.URL, "token", nil)
+		dj.RetryPolicy = testRetryPolicy()
+		dj.RetryPolicy.RetryNonIdempotent = true
+
+		_, err := dj.ImportScan.Create(context.Background(), &ImportScan{
+			ScanType:   Str("Trivy Scan"),
+			FileUpload: &FileUpload{Name: "trivy.json", Reader: bytes.NewReader(report)},
+		})
+		if !cmp.Equal(err, nil) {
+			t.Fatalf("error: %s", err)
+		}
+		if attempts != 3 {
+			t.Errorf("expected 3 attempts, got %d", attempts)
+		}
+	})
+}
+
+func TestRetryPolicy_backoff(t *testing.T) {
+	p := &RetryPolicy{MinBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+
+	for attempt, limit := range map[int]time.Duration{
+		1: 100 * time.Millisecond,
+		2: 200 * time.Millisecond,
+		4: 800 * time.Millisecond,
+		8: time.Second,
+	} {
+		d := p.backoff(nil, attempt)
+		if d < limit/2 || d > limit {
+			t.Errorf("attempt %d: expected a backoff between %s and %s, got %s", attempt, limit/2, limit, d)
+		}
+	}
+}
@@ -0,0 +1,290 @@
+package defectdojo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+type RiskAcceptancesService struct {
+	client *Client
+}
+
+type RiskAcceptance struct {
+	Id                    *int       `json:"id,omitempty"`
+	Name                  *string    `json:"name,omitempty"`
+	Recommendation        *string    `json:"recommendation,omitempty"`
+	RecommendationDetails *string    `json:"recommendation_details,omitempty"`
+	Decision              *string    `json:"decision,omitempty"`
+	DecisionDetails       *string    `json:"decision_details,omitempty"`
+	AcceptedBy            *string    `json:"accepted_by,omitempty"`
+	Path                  *string    `json:"path,omitempty"`
+	ExpirationDate        *time.Time `json:"expiration_date,omitempty"`
+	ExpirationDateWarned  *time.Time `json:"expiration_date_warned,omitempty"`
+	ExpirationDateHandled *time.Time `json:"expiration_date_handled,omitempty"`
+	ReactivateExpired     *bool      `json:"reactivate_expired,omitempty"`
+	RestartSlaExpired     *bool      `json:"restart_sla_expired,omitempty"`
+	Created               *time.Time `json:"created,omitempty"`
+	Updated               *time.Time `json:"updated,omitempty"`
+	Owner                 *int       `json:"owner,omitempty"`
+	AcceptedFindings      *[]int     `json:"accepted_findings,omitempty"`
+	Notes                 *[]int     `json:"notes,omitempty"`
+}
+
+type RiskAcceptances struct {
+	Count    *int              `json:"count,omitempty"`
+	Next     *string           `json:"next,omitempty"`
+	Previous *string           `json:"previous,omitempty"`
+	Results  *[]RiskAcceptance `json:"results,omitempty"`
+}
+
+type RiskAcceptancesOptions struct {
+	Limit             int
+	Offset            int
+	ID                int
+	Name              string
+	Owner             int
+	Decision          string
+	AcceptedFindings  int
+	ExpirationDate    string
+	ReactivateExpired string
+	Ordering          string
+}
+
+func (o *RiskAcceptancesOptions) ToString() string {
+	if o == nil {
+		return ""
+	}
+
+	v := url.Values{}
+	if o.Limit > 0 {
+		v.Set("limit", strconv.Itoa(o.Limit))
+	}
+	if o.Offset > 0 {
+		v.Set("offset", strconv.Itoa(o.Offset))
+	}
+	if o.ID > 0 {
+		v.Set("id", strconv.Itoa(o.ID))
+	}
+	if len(o.Name) > 0 {
+		v.Set("name", o.Name)
+	}
+	if o.Owner > 0 {
+		v.Set("owner", strconv.Itoa(o.Owner))
+	}
+	if len(o.Decision) > 0 {
+		v.Set("decision", o.Decision)
+	}
+	if o.AcceptedFindings > 0 {
+		v.Set("accepted_findings", strconv.Itoa(o.AcceptedFindings))
+	}
+	if len(o.ExpirationDate) > 0 {
+		v.Set("expiration_date", o.ExpirationDate)
+	}
+	if len(o.ReactivateExpired) > 0 {
+		v.Set("reactivate_expired", o.ReactivateExpired)
+	}
+	if len(o.Ordering) > 0 {
+		v.Set("o", o.Ordering)
+	}
+
+	return "?" + v.Encode()
+}
+
+func (c *RiskAcceptancesService) List(ctx context.Context, options *RiskAcceptancesOptions) (*RiskAcceptances, error) {
+	path := fmt.Sprintf("%s/risk_acceptance/%s", c.client.BaseURL, options.ToString())
+
+	req, err := http.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := RiskAcceptances{}
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// All returns an iterator over all risk acceptances matching the given options,
+// fetching further pages from DefectDojo as the iteration advances.
+func (c *RiskAcceptancesService) All(ctx context.Context, options *RiskAcceptancesOptions) iter.Seq2[RiskAcceptance, error] {
+	path := fmt.Sprintf("%s/risk_acceptance/%s", c.client.BaseURL, options.ToString())
+
+	return all[RiskAcceptance](ctx, c.client, path)
+}
+
+func (c *RiskAcceptancesService) Read(ctx context.Context, id int) (*RiskAcceptance, error) {
+	path := fmt.Sprintf("%s/risk_acceptance/%d/", c.client.BaseURL, id)
+
+	req, err := http.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(RiskAcceptance)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *RiskAcceptancesService) Create(ctx context.Context, u *RiskAcceptance) (*RiskAcceptance, error) {
+	path := fmt.Sprintf("%s/risk_acceptance/", c.client.BaseURL)
+
+	postJSON, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPost, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(RiskAcceptance)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *RiskAcceptancesService) Update(ctx context.Context, id int, u *RiskAcceptance) (*RiskAcceptance, error) {
+	path := fmt.Sprintf("%s/risk_acceptance/%d/", c.client.BaseURL, id)
+
+	postJSON, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPut, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(RiskAcceptance)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *RiskAcceptancesService) PartialUpdate(ctx context.Context, id int, u *RiskAcceptance) (*RiskAcceptance, error) {
+	path := fmt.Sprintf("%s/risk_acceptance/%d/", c.client.BaseURL, id)
+
+	postJSON, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPatch, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(RiskAcceptance)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *RiskAcceptancesService) Delete(ctx context.Context, id int) (*RiskAcceptance, error) {
+	path := fmt.Sprintf("%s/risk_acceptance/%d/", c.client.BaseURL, id)
+
+	req, err := http.NewRequest(http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(RiskAcceptance)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// DownloadProof writes the proof document attached to the risk acceptance to w.
+func (c *RiskAcceptancesService) DownloadProof(ctx context.Context, id int, w io.Writer) error {
+	path := fmt.Sprintf("%s/risk_acceptance/%d/download_proof/", c.client.BaseURL, id)
+
+	req, err := http.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return err
+	}
+
+	req = req.WithContext(ctx)
+
+	return c.client.sendRequest(req, w)
+}
+
+// AddFindings adds findings to the risk acceptance, keeping the ones already accepted.
+// The accepted findings are read and written back with a partial update.
+func (c *RiskAcceptancesService) AddFindings(ctx context.Context, id int, findings []int) (*RiskAcceptance, error) {
+	ra, err := c.Read(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	accepted := mergeUnique(ra.AcceptedFindings, findings)
+	return c.PartialUpdate(ctx, id, &RiskAcceptance{AcceptedFindings: &accepted})
+}
+
+// RemoveFindings removes findings from the risk acceptance.
+// Like AddFindings, it reads the accepted findings and writes the remaining ones back.
+func (c *RiskAcceptancesService) RemoveFindings(ctx context.Context, id int, findings []int) (*RiskAcceptance, error) {
+	ra, err := c.Read(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	remaining := without(ra.AcceptedFindings, findings)
+	return c.PartialUpdate(ctx, id, &RiskAcceptance{AcceptedFindings: &remaining})
+}
+
+// ExpiringWithin returns the risk acceptances matching options that expire between now
+// and now plus window, and whose expiration has not been handled yet.
+// DefectDojo cannot filter on expiration date ranges, so all matching risk acceptances
+// are fetched and filtered locally.
+func (c *RiskAcceptancesService) ExpiringWithin(ctx context.Context, window time.Duration, options *RiskAcceptancesOptions) ([]RiskAcceptance, error) {
+	now := time.Now()
+	deadline := now.Add(window)
+
+	var res []RiskAcceptance
+	for ra, err := range c.All(ctx, options) {
+		if err != nil {
+			return nil, err
+		}
+		if ra.ExpirationDate == nil || ra.ExpirationDateHandled != nil {
+			continue
+		}
+		if ra.ExpirationDate.Before(now) || ra.ExpirationDate.After(deadline) {
+			continue
+		}
+		res = append(res, ra)
+	}
+
+	return res, nil
+}
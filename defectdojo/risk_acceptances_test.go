@@ -0,0 +1,442 @@
+package defectdojo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRiskAcceptancesService_List(t *testing.T) {
+	response := `{
+		"count": 1,
+		"next": null,
+		"previous": null,
+		"results": [
+			{
+				"id": 6,
+				"name": "Accept legacy TLS",
+				"recommendation": "A",
+				"decision": "A",
+				"accepted_by": "CISO",
+				"expiration_date": "2024-12-31T00:00:00Z",
+				"reactivate_expired": true,
+				"owner": 1,
+				"accepted_findings": [10, 11]
+			}
+		]
+	}`
+
+	expected := RiskAcceptances{
+		Count:    Int(1),
+		Next:     nil,
+		Previous: nil,
+		Results: &[]RiskAcceptance{
+			{
+				Id:                Int(6),
+				Name:              Str("Accept legacy TLS"),
+				Recommendation:    Str("A"),
+				Decision:          Str("A"),
+				AcceptedBy:        Str("CISO"),
+				ExpirationDate:    Date(time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)),
+				ReactivateExpired: Bool(true),
+				Owner:             Int(1),
+				AcceptedFindings:  &[]int{10, 11},
+			},
+		},
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected GET request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/risk_acceptance/") {
+			t.Errorf("Expected /risk_acceptance/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.RiskAcceptances.List(context.Background(), &RiskAcceptancesOptions{
+		Limit: 10,
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestRiskAcceptancesService_Read(t *testing.T) {
+	response := `{
+		"id": 6,
+		"name": "Accept legacy TLS",
+		"recommendation": "A",
+		"decision": "A",
+		"accepted_by": "CISO",
+		"expiration_date": "2024-12-31T00:00:00Z",
+		"reactivate_expired": true,
+		"owner": 1,
+		"accepted_findings": [10, 11]
+	}`
+
+	expected := RiskAcceptance{
+		Id:                Int(6),
+		Name:              Str("Accept legacy TLS"),
+		Recommendation:    Str("A"),
+		Decision:          Str("A"),
+		AcceptedBy:        Str("CISO"),
+		ExpirationDate:    Date(time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)),
+		ReactivateExpired: Bool(true),
+		Owner:             Int(1),
+		AcceptedFindings:  &[]int{10, 11},
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected GET request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/risk_acceptance/6/") {
+			t.Errorf("Expected /risk_acceptance/6/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.RiskAcceptances.Read(context.Background(), 6)
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestRiskAcceptancesService_Create(t *testing.T) {
+	response := `{
+		"id": 6,
+		"name": "Accept legacy TLS",
+		"recommendation": "A",
+		"decision": "A",
+		"accepted_by": "CISO",
+		"expiration_date": "2024-12-31T00:00:00Z",
+		"reactivate_expired": true,
+		"owner": 1,
+		"accepted_findings": [10, 11]
+	}`
+
+	expected := RiskAcceptance{
+		Id:                Int(6),
+		Name:              Str("Accept legacy TLS"),
+		Recommendation:    Str("A"),
+		Decision:          Str("A"),
+		AcceptedBy:        Str("CISO"),
+		ExpirationDate:    Date(time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)),
+		ReactivateExpired: Bool(true),
+		Owner:             Int(1),
+		AcceptedFindings:  &[]int{10, 11},
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("Expected POST request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/risk_acceptance/") {
+			t.Errorf("Expected /risk_acceptance/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.RiskAcceptances.Create(context.Background(), &RiskAcceptance{
+		Name:             Str("Accept legacy TLS"),
+		Owner:            Int(1),
+		AcceptedFindings: &[]int{10, 11},
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestRiskAcceptancesService_Update(t *testing.T) {
+	response := `{
+		"id": 6,
+		"name": "Accept legacy TLS",
+		"recommendation": "A",
+		"decision": "A",
+		"accepted_by": "CISO",
+		"expiration_date": "2024-12-31T00:00:00Z",
+		"reactivate_expired": true,
+		"owner": 1,
+		"accepted_findings": [10, 11]
+	}`
+
+	expected := RiskAcceptance{
+		Id:                Int(6),
+		Name:              Str("Accept legacy TLS"),
+		Recommendation:    Str("A"),
+		Decision:          Str("A"),
+		AcceptedBy:        Str("CISO"),
+		ExpirationDate:    Date(time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)),
+		ReactivateExpired: Bool(true),
+		Owner:             Int(1),
+		AcceptedFindings:  &[]int{10, 11},
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Errorf("Expected PUT request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/risk_acceptance/6/") {
+			t.Errorf("Expected /risk_acceptance/6/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.RiskAcceptances.Update(context.Background(), 6, &RiskAcceptance{
+		Name:             Str("Accept legacy TLS"),
+		Owner:            Int(1),
+		AcceptedFindings: &[]int{10, 11},
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestRiskAcceptancesService_PartialUpdate(t *testing.T) {
+	response := `{
+		"id": 6,
+		"name": "Accept legacy TLS",
+		"recommendation": "A",
+		"decision": "A",
+		"accepted_by": "CISO",
+		"expiration_date": "2024-12-31T00:00:00Z",
+		"reactivate_expired": true,
+		"owner": 1,
+		"accepted_findings": [10, 11]
+	}`
+
+	expected := RiskAcceptance{
+		Id:                Int(6),
+		Name:              Str("Accept legacy TLS"),
+		Recommendation:    Str("A"),
+		Decision:          Str("A"),
+		AcceptedBy:        Str("CISO"),
+		ExpirationDate:    Date(time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)),
+		ReactivateExpired: Bool(true),
+		Owner:             Int(1),
+		AcceptedFindings:  &[]int{10, 11},
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch {
+			t.Errorf("Expected PATCH request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/risk_acceptance/6/") {
+			t.Errorf("Expected /risk_acceptance/6/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.RiskAcceptances.PartialUpdate(context.Background(), 6, &RiskAcceptance{
+		Name:             Str("Accept legacy TLS"),
+		Owner:            Int(1),
+		AcceptedFindings: &[]int{10, 11},
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestRiskAcceptancesService_Delete(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("Expected DELETE request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/risk_acceptance/6/") {
+			t.Errorf("Expected /risk_acceptance/6/ in path, got %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	_, err := dj.RiskAcceptances.Delete(context.Background(), 6)
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+}
+
+func TestRiskAcceptancesOptions_ToString(t *testing.T) {
+	tests := []struct {
+		name     string
+		options  *RiskAcceptancesOptions
+		expected string
+	}{
+		{
+			name: "owner only",
+			options: &RiskAcceptancesOptions{
+				Owner: 1,
+			},
+			expected: "?owner=1",
+		},
+		{
+			name: "all fields",
+			options: &RiskAcceptancesOptions{
+				Limit:            10,
+				Offset:           20,
+				Name:             "legacy TLS",
+				Decision:         "A",
+				AcceptedFindings: 10,
+				Ordering:         "expiration_date",
+			},
+			expected: "?accepted_findings=10&decision=A&limit=10&name=legacy+TLS&o=expiration_date&offset=20",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := tt.options.ToString()
+			if actual != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestRiskAcceptancesService_DownloadProof(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected GET request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/risk_acceptance/6/download_proof/") {
+			t.Errorf("Expected /risk_acceptance/6/download_proof/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprint(w, "%PDF-1.7")
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	var sb strings.Builder
+	if err := dj.RiskAcceptances.DownloadProof(context.Background(), 6, &sb); !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+	if sb.String() != "%PDF-1.7" {
+		t.Errorf("unexpected contents %s", sb.String())
+	}
+}
+
+func TestRiskAcceptancesService_Findings(t *testing.T) {
+	accepted := []int{10, 11}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.Contains(r.URL.Path, "/risk_acceptance/6/") {
+			t.Errorf("Expected /risk_acceptance/6/ in path, got %s", r.URL.Path)
+		}
+		switch r.Method {
+		case http.MethodGet:
+		case http.MethodPatch:
+			var body RiskAcceptance
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("cannot decode body: %s", err)
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			accepted = *body.AcceptedFindings
+		default:
+			t.Errorf("unexpected %s request", r.Method)
+		}
+		_ = json.NewEncoder(w).Encode(&RiskAcceptance{Id: Int(6), AcceptedFindings: &accepted})
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.RiskAcceptances.AddFindings(context.Background(), 6, []int{11, 12})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+	if !cmp.Equal(*actual.AcceptedFindings, []int{10, 11, 12}) {
+		t.Errorf("unexpected findings %v", *actual.AcceptedFindings)
+	}
+
+	actual, err = dj.RiskAcceptances.RemoveFindings(context.Background(), 6, []int{10})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+	if !cmp.Equal(*actual.AcceptedFindings, []int{11, 12}) {
+		t.Errorf("unexpected findings %v", *actual.AcceptedFindings)
+	}
+}
+
+func TestRiskAcceptancesService_ExpiringWithin(t *testing.T) {
+	now := time.Now().UTC()
+	results := []RiskAcceptance{
+		{Id: Int(1), ExpirationDate: Date(now.Add(24 * time.Hour))},
+		{Id: Int(2), ExpirationDate: Date(now.Add(60 * 24 * time.Hour))},
+		{Id: Int(3), ExpirationDate: Date(now.Add(-24 * time.Hour))},
+		{Id: Int(4), ExpirationDate: Date(now.Add(48 * time.Hour)), ExpirationDateHandled: Date(now)},
+		{Id: Int(5)},
+		{Id: Int(6), ExpirationDate: Date(now.Add(29 * 24 * time.Hour))},
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected GET request, got %s", r.Method)
+		}
+		if r.URL.Query().Get("owner") != "1" {
+			t.Errorf("Expected owner=1, got %s", r.URL.RawQuery)
+		}
+		_ = json.NewEncoder(w).Encode(&RiskAcceptances{Count: Int(len(results)), Results: &results})
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.RiskAcceptances.ExpiringWithin(context.Background(), 30*24*time.Hour, &RiskAcceptancesOptions{Owner: 1})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	var ids []int
+	for _, ra := range actual {
+		ids = append(ids, *ra.Id)
+	}
+	if !cmp.Equal(ids, []int{1, 6}) {
+		t.Errorf("should have been equal, %v, %v", ids, []int{1, 6})
+	}
+}
@@ -0,0 +1,116 @@
+package defectdojo
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// RolesService reads the roles DefectDojo grants through memberships and global roles.
+// Roles are predefined and cannot be changed through the API.
+type RolesService struct {
+	client *Client
+}
+
+type Role struct {
+	Id      *RoleID `json:"id,omitempty"`
+	Name    *string `json:"name,omitempty"`
+	IsOwner *bool   `json:"is_owner,omitempty"`
+}
+
+type Roles struct {
+	Count    *int    `json:"count,omitempty"`
+	Next     *string `json:"next,omitempty"`
+	Previous *string `json:"previous,omitempty"`
+	Results  *[]Role `json:"results,omitempty"`
+}
+
+type RolesOptions struct {
+	Limit  int
+	Offset int
+	ID     int
+	Name   string
+}
+
+func (o *RolesOptions) ToString() string {
+	if o == nil {
+		return ""
+	}
+
+	v := url.Values{}
+	if o.Limit > 0 {
+		v.Set("limit", strconv.Itoa(o.Limit))
+	}
+	if o.Offset > 0 {
+		v.Set("offset", strconv.Itoa(o.Offset))
+	}
+	if o.ID > 0 {
+		v.Set("id", strconv.Itoa(o.ID))
+	}
+	if len(o.Name) > 0 {
+		v.Set("name", o.Name)
+	}
+
+	return "?" + v.Encode()
+}
+
+func (c *RolesService) List(ctx context.Context, options *RolesOptions) (*Roles, error) {
+	path := fmt.Sprintf("%s/roles/%s", c.client.BaseURL, options.ToString())
+
+	req, err := http.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := Roles{}
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// All returns an iterator over all roles matching the given options,
+// fetching further pages from DefectDojo as the iteration advances.
+func (c *RolesService) All(ctx context.Context, options *RolesOptions) iter.Seq2[Role, error] {
+	path := fmt.Sprintf("%s/roles/%s", c.client.BaseURL, options.ToString())
+
+	return all[Role](ctx, c.client, path)
+}
+
+func (c *RolesService) Read(ctx context.Context, id int) (*Role, error) {
+	path := fmt.Sprintf("%s/roles/%d/", c.client.BaseURL, id)
+
+	req, err := http.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(Role)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// ByName returns the role with the given name, such as "Writer" or "Owner".
+func (c *RolesService) ByName(ctx context.Context, name string) (*Role, error) {
+	for r, err := range c.All(ctx, &RolesOptions{Name: name}) {
+		if err != nil {
+			return nil, err
+		}
+		if r.Name != nil && *r.Name == name {
+			return &r, nil
+		}
+	}
+
+	return nil, fmt.Errorf("role %q not found", name)
+}
@@ -0,0 +1,150 @@
+package defectdojo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRolesService_List(t *testing.T) {
+	response := `{
+		"count": 1,
+		"next": null,
+		"previous": null,
+		"results": [
+			{
+				"id": 3,
+				"name": "Maintainer",
+				"is_owner": false
+			}
+		]
+	}`
+
+	expected := Roles{
+		Count:    Int(1),
+		Next:     nil,
+		Previous: nil,
+		Results: &[]Role{
+			{
+				Id:      Ptr(RoleMaintainer),
+				Name:    Str("Maintainer"),
+				IsOwner: Bool(false),
+			},
+		},
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected GET request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/roles/") {
+			t.Errorf("Expected /roles/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.Roles.List(context.Background(), &RolesOptions{
+		Limit: 10,
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestRolesService_Read(t *testing.T) {
+	response := `{
+		"id": 3,
+		"name": "Maintainer",
+		"is_owner": false
+	}`
+
+	expected := Role{
+		Id:      Ptr(RoleMaintainer),
+		Name:    Str("Maintainer"),
+		IsOwner: Bool(false),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected GET request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/roles/3/") {
+			t.Errorf("Expected /roles/3/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.Roles.Read(context.Background(), 3)
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestRolesOptions_ToString(t *testing.T) {
+	tests := []struct {
+		name     string
+		options  *RolesOptions
+		expected string
+	}{
+		{
+			name: "name only",
+			options: &RolesOptions{
+				Name: "API Importer",
+			},
+			expected: "?name=API+Importer",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := tt.options.ToString()
+			if actual != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestRolesService_ByName(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("name") != "Writer" {
+			_, _ = fmt.Fprintln(w, `{"count": 0, "next": null, "previous": null, "results": []}`)
+			return
+		}
+		_, _ = fmt.Fprintln(w, `{"count": 1, "next": null, "previous": null, "results": [{"id": 2, "name": "Writer", "is_owner": false}]}`)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.Roles.ByName(context.Background(), "Writer")
+	if !cmp.Equal(err, nil) {
+		t.Fatalf("error: %s", err)
+	}
+	if *actual.Id != RoleWriter {
+		t.Errorf("expected role %s, got %s", RoleWriter, *actual.Id)
+	}
+
+	if _, err := dj.Roles.ByName(context.Background(), "Auditor"); cmp.Equal(err, nil) {
+		t.Errorf("expected an error for an unknown role")
+	}
+}
@@ -0,0 +1,405 @@
+package defectdojo
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"sync"
+)
+
+// ScanType is the name of a DefectDojo parser, as expected by the scan_type field of scan imports,
+// where it is set with its Ptr method.
+type ScanType string
+
+// ScanTypeInfo describes what a DefectDojo parser expects.
+type ScanTypeInfo struct {
+	// Name is the scan type to send when importing a report
+	Name ScanType
+	// FileFormats lists the file extensions the parser reads, empty for parsers that do not take a file
+	FileFormats []string
+	// DedupeByUniqueID tells whether DefectDojo deduplicates the findings of the parser
+	// on the unique ID reported by the tool rather than on a hash code
+	DedupeByUniqueID bool
+	// NeedsService tells whether the parser pulls findings from the tool itself,
+	// through a product API scan configuration, instead of reading an uploaded report
+	NeedsService bool
+}
+
+// The scan types supported by DefectDojo 2.x. The list is maintained by hand from the parsers
+// under dojo/tools in the DefectDojo sources, their get_scan_types and get_description methods,
+// and the DEDUPLICATION_ALGORITHM_PER_PARSER and HASHCODE_FIELDS_PER_SCANNER settings.
+// It may lag behind new DefectDojo releases: register missing parsers with RegisterScanType,
+// or set AllowUnknownScanTypes on the client.
+const (
+	ScanTypeAcunetix                           ScanType = "Acunetix Scan"
+	ScanTypeAnchoreCTLVulns                    ScanType = "AnchoreCTL Vuln Report"
+	ScanTypeAnchoreEngine                      ScanType = "Anchore Engine Scan"
+	ScanTypeAnchoreEnterprisePolicyCheck       ScanType = "Anchore Enterprise Policy Check"
+	ScanTypeAnchoreGrype                       ScanType = "Anchore Grype"
+	ScanTypeAqua                               ScanType = "Aqua Scan"
+	ScanTypeArachni                            ScanType = "Arachni Scan"
+	ScanTypeAuditJS                            ScanType = "AuditJS Scan"
+	ScanTypeAWSInspector2                      ScanType = "AWS Inspector2 Scan"
+	ScanTypeAWSProwler                         ScanType = "AWS Prowler Scan"
+	ScanTypeAWSProwlerV3                       ScanType = "AWS Prowler V3"
+	ScanTypeAWSSecurityHub                     ScanType = "AWS Security Hub Scan"
+	ScanTypeAzureSecurityCenterRecommendations ScanType = "Azure Security Center Recommendations Scan"
+	ScanTypeBandit                             ScanType = "Bandit Scan"
+	ScanTypeBlackDuckAPI                       ScanType = "BlackDuck API"
+	ScanTypeBlackduckComponentRisk             ScanType = "Blackduck Component Risk"
+	ScanTypeBlackduckHub                       ScanType = "Blackduck Hub Scan"
+	ScanTypeBrakeman                           ScanType = "Brakeman Scan"
+	ScanTypeBugcrowdAPI                        ScanType = "Bugcrowd API Import"
+	ScanTypeBundlerAudit                       ScanType = "Bundler-Audit Scan"
+	ScanTypeBurpEnterprise                     ScanType = "Burp Enterprise Scan"
+	ScanTypeBurpGraphQLAPI                     ScanType = "Burp GraphQL API"
+	ScanTypeBurpRESTAPI                        ScanType = "Burp REST API"
+	ScanTypeBurp                               ScanType = "Burp Scan"
+	ScanTypeCargoAudit                         ScanType = "Cargo Audit Scan"
+	ScanTypeChefInspect                        ScanType = "Chef Inspect Log"
+	ScanTypeCheckmarx                          ScanType = "Checkmarx Scan"
+	ScanTypeCheckmarxDetailed                  ScanType = "Checkmarx Scan detailed"
+	ScanTypeCheckmarxOne                       ScanType = "Checkmarx One Scan"
+	ScanTypeCheckmarxOSA                       ScanType = "Checkmarx OSA"
+	ScanTypeCheckov                            ScanType = "Checkov Scan"
+	ScanTypeClair                              ScanType = "Clair Scan"
+	ScanTypeCloudsploit                        ScanType = "Cloudsploit Scan"
+	ScanTypeCobaltIO                           ScanType = "Cobalt.io Scan"
+	ScanTypeCobaltIOAPI                        ScanType = "Cobalt.io API Import"
+	ScanTypeCodechecker                        ScanType = "Codechecker Report native"
+	ScanTypeContrast                           ScanType = "Contrast Scan"
+	ScanTypeCoverityAPI                        ScanType = "Coverity API"
+	ScanTypeCrashtest                          ScanType = "Crashtest Security JSON File"
+	ScanTypeCredScan                           ScanType = "CredScan Scan"
+	ScanTypeCycloneDX                          ScanType = "CycloneDX Scan"
+	ScanTypeDependencyCheck                    ScanType = "Dependency Check Scan"
+	ScanTypeDependencyTrack                    ScanType = "Dependency Track Finding Packaging Format (FPF) Export"
+	ScanTypeDetectSecrets                      ScanType = "Detect-secrets Scan"
+	ScanTypeDockerBenchSecurity                ScanType = "docker-bench-security Scan"
+	ScanTypeDockle                             ScanType = "Dockle Scan"
+	ScanTypeDrHeader                           ScanType = "DrHeader JSON Importer"
+	ScanTypeDSOP                               ScanType = "DSOP Scan"
+	ScanTypeEdgescan                           ScanType = "Edgescan Scan"
+	ScanTypeESLint                             ScanType = "ESLint Scan"
+	ScanTypeFortify                            ScanType = "Fortify Scan"
+	ScanTypeGeneric                            ScanType = "Generic Findings Import"
+	ScanTypeGgshield                           ScanType = "Ggshield Scan"
+	ScanTypeGithubVulnerability                ScanType = "Github Vulnerability Scan"
+	ScanTypeGitLabAPIFuzzing                   ScanType = "GitLab API Fuzzing Report Scan"
+	ScanTypeGitLabContainer                    ScanType = "GitLab Container Scan"
+	ScanTypeGitLabDAST                         ScanType = "GitLab DAST Report"
+	ScanTypeGitLabDependencyScanning           ScanType = "GitLab Dependency Scanning Report"
+	ScanTypeGitLabSAST                         ScanType = "GitLab SAST Report"
+	ScanTypeGitLabSecretDetection              ScanType = "GitLab Secret Detection Report"
+	ScanTypeGitleaks                           ScanType = "Gitleaks Scan"
+	ScanTypeGosec                              ScanType = "Gosec Scanner"
+	ScanTypeGovulncheck                        ScanType = "Govulncheck Scanner"
+	ScanTypeHackerOne                          ScanType = "HackerOne Cases"
+	ScanTypeHadolint                           ScanType = "Hadolint Dockerfile check"
+	ScanTypeHarbor                             ScanType = "Harbor Vulnerability Scan"
+	ScanTypeHCLAppScan                         ScanType = "HCLAppScan XML"
+	ScanTypeHorusec                            ScanType = "Horusec Scan"
+	ScanTypeHuskyCI                            ScanType = "HuskyCI Report"
+	ScanTypeHydra                              ScanType = "Hydra Scan"
+	ScanTypeIBMAppScanDAST                     ScanType = "IBM AppScan DAST"
+	ScanTypeImmuniweb                          ScanType = "Immuniweb Scan"
+	ScanTypeIntSights                          ScanType = "IntSights Report"
+	ScanTypeJFrogXray                          ScanType = "JFrog Xray Scan"
+	ScanTypeJFrogXrayUnified                   ScanType = "JFrog Xray Unified Scan"
+	ScanTypeKICS                               ScanType = "KICS Scan"
+	ScanTypeKiuwan                             ScanType = "Kiuwan Scan"
+	ScanTypeKubeBench                          ScanType = "kube-bench Scan"
+	ScanTypeKubeaudit                          ScanType = "Kubeaudit Scan"
+	ScanTypeKubehunter                         ScanType = "Kubehunter Scan"
+	ScanTypeKubescape                          ScanType = "Kubescape JSON Importer"
+	ScanTypeMeterian                           ScanType = "Meterian Scan"
+	ScanTypeMicrofocusWebinspect               ScanType = "Microfocus Webinspect Scan"
+	ScanTypeMobSF                              ScanType = "MobSF Scan"
+	ScanTypeMobsfscan                          ScanType = "Mobsfscan Scan"
+	ScanTypeMozillaObservatory                 ScanType = "Mozilla Observatory Scan"
+	ScanTypeNancy                              ScanType = "Nancy Scan"
+	ScanTypeNetsparker                         ScanType = "Netsparker Scan"
+	ScanTypeNeuVectorCompliance                ScanType = "NeuVector (compliance)"
+	ScanTypeNeuVectorREST                      ScanType = "NeuVector (REST)"
+	ScanTypeNexpose                            ScanType = "Nexpose Scan"
+	ScanTypeNikto                              ScanType = "Nikto Scan"
+	ScanTypeNmap                               ScanType = "Nmap Scan"
+	ScanTypeNodeSecurityPlatform               ScanType = "Node Security Platform Scan"
+	ScanTypeNPMAudit                           ScanType = "NPM Audit Scan"
+	ScanTypeNPMAuditV7                         ScanType = "NPM Audit v7+ Scan"
+	ScanTypeNuclei                             ScanType = "Nuclei Scan"
+	ScanTypeOpenscap                           ScanType = "Openscap Vulnerability Scan"
+	ScanTypeOpenVAS                            ScanType = "OpenVAS Parser"
+	ScanTypeORT                                ScanType = "ORT evaluated model Importer"
+	ScanTypeOSV                                ScanType = "OSV Scan"
+	ScanTypeOutpost24                          ScanType = "Outpost24 Scan"
+	ScanTypePHPSecurityAuditV2                 ScanType = "PHP Security Audit v2"
+	ScanTypePHPSymfonySecurityCheck            ScanType = "PHP Symfony Security Check"
+	ScanTypePipAudit                           ScanType = "pip-audit Scan"
+	ScanTypePMD                                ScanType = "PMD Scan"
+	ScanTypePopeye                             ScanType = "Popeye Scan"
+	ScanTypeQualys                             ScanType = "Qualys Scan"
+	ScanTypeQualysInfrastructureWebGUI         ScanType = "Qualys Infrastructure Scan (WebGUI XML)"
+	ScanTypeQualysWebapp                       ScanType = "Qualys Webapp Scan"
+	ScanTypeRetireJS                           ScanType = "Retire.js Scan"
+	ScanTypeRiskReconAPI                       ScanType = "Risk Recon API Importer"
+	ScanTypeRubocop                            ScanType = "Rubocop Scan"
+	ScanTypeRustyHog                           ScanType = "Rusty Hog Scan"
+	ScanTypeSARIF                              ScanType = "SARIF"
+	ScanTypeScantist                           ScanType = "Scantist Scan"
+	ScanTypeScoutSuite                         ScanType = "Scout Suite Scan"
+	ScanTypeSemgrep                            ScanType = "Semgrep JSON Report"
+	ScanTypeSKF                                ScanType = "SKF Scan"
+	ScanTypeSnyk                               ScanType = "Snyk Scan"
+	ScanTypeSnykCode                           ScanType = "Snyk Code Scan"
+	ScanTypeSolarAppscreener                   ScanType = "Solar Appscreener Scan"
+	ScanTypeSonarQube                          ScanType = "SonarQube Scan"
+	ScanTypeSonarQubeAPI                       ScanType = "SonarQube API Import"
+	ScanTypeSonarQubeDetailed                  ScanType = "SonarQube Scan detailed"
+	ScanTypeSonatypeApplication                ScanType = "Sonatype Application Scan"
+	ScanTypeSpotBugs                           ScanType = "SpotBugs Scan"
+	ScanTypeSSLLabs                            ScanType = "SSL Labs Scan"
+	ScanTypeSslscan                            ScanType = "Sslscan"
+	ScanTypeSSLyzeJSON                         ScanType = "SSLyze Scan (JSON)"
+	ScanTypeStackHawk                          ScanType = "StackHawk HawkScan"
+	ScanTypeTalisman                           ScanType = "Talisman Scan"
+	ScanTypeTenable                            ScanType = "Tenable Scan"
+	ScanTypeTerrascan                          ScanType = "Terrascan Scan"
+	ScanTypeTestssl                            ScanType = "Testssl Scan"
+	ScanTypeTFSec                              ScanType = "TFSec Scan"
+	ScanTypeTrivy                              ScanType = "Trivy Scan"
+	ScanTypeTrivyOperator                      ScanType = "Trivy Operator Scan"
+	ScanTypeTrufflehog                         ScanType = "Trufflehog Scan"
+	ScanTypeTrufflehog3                        ScanType = "Trufflehog3 Scan"
+	ScanTypeTrustwaveCSV                       ScanType = "Trustwave Scan (CSV)"
+	ScanTypeTwistlockImage                     ScanType = "Twistlock Image Scan"
+	ScanTypeVcg                                ScanType = "Vcg Scan"
+	ScanTypeVeracode                           ScanType = "Veracode Scan"
+	ScanTypeVeracodeSourceClear                ScanType = "Veracode SourceClear Scan"
+	ScanTypeVulners                            ScanType = "Vulners"
+	ScanTypeWapiti                             ScanType = "Wapiti Scan"
+	ScanTypeWFuzz                              ScanType = "WFuzz JSON report"
+	ScanTypeWhispers                           ScanType = "Whispers Scan"
+	ScanTypeWhiteHatSentinel                   ScanType = "WhiteHat Sentinel"
+	ScanTypeWhitesource                        ScanType = "Whitesource Scan"
+	ScanTypeWiz                                ScanType = "Wiz Scan"
+	ScanTypeWpscan                             ScanType = "Wpscan"
+	ScanTypeXanitizer                          ScanType = "Xanitizer Scan"
+	ScanTypeYarnAudit                          ScanType = "Yarn Audit Scan"
+	ScanTypeZAP                                ScanType = "ZAP Scan"
+)
+
+var (
+	scanTypesMu sync.RWMutex
+	scanTypes   = map[ScanType]ScanTypeInfo{
+		ScanTypeAcunetix:                           {Name: ScanTypeAcunetix, FileFormats: []string{"xml", "json"}},
+		ScanTypeAnchoreCTLVulns:                    {Name: ScanTypeAnchoreCTLVulns, FileFormats: []string{"json"}},
+		ScanTypeAnchoreEngine:                      {Name: ScanTypeAnchoreEngine, FileFormats: []string{"json"}},
+		ScanTypeAnchoreEnterprisePolicyCheck:       {Name: ScanTypeAnchoreEnterprisePolicyCheck, FileFormats: []string{"json"}},
+		ScanTypeAnchoreGrype:                       {Name: ScanTypeAnchoreGrype, FileFormats: []string{"json"}},
+		ScanTypeAqua:                               {Name: ScanTypeAqua, FileFormats: []string{"json"}},
+		ScanTypeArachni:                            {Name: ScanTypeArachni, FileFormats: []string{"json"}},
+		ScanTypeAuditJS:                            {Name: ScanTypeAuditJS, FileFormats: []string{"json"}},
+		ScanTypeAWSInspector2:                      {Name: ScanTypeAWSInspector2, FileFormats: []string{"json"}},
+		ScanTypeAWSProwler:                         {Name: ScanTypeAWSProwler, FileFormats: []string{"csv", "json"}},
+		ScanTypeAWSProwlerV3:                       {Name: ScanTypeAWSProwlerV3, FileFormats: []string{"json"}},
+		ScanTypeAWSSecurityHub:                     {Name: ScanTypeAWSSecurityHub, FileFormats: []string{"json"}, DedupeByUniqueID: true},
+		ScanTypeAzureSecurityCenterRecommendations: {Name: ScanTypeAzureSecurityCenterRecommendations, FileFormats: []string{"csv"}},
+		ScanTypeBandit:                             {Name: ScanTypeBandit, FileFormats: []string{"json"}},
+		ScanTypeBlackDuckAPI:                       {Name: ScanTypeBlackDuckAPI, DedupeByUniqueID: true, NeedsService: true},
+		ScanTypeBlackduckComponentRisk:             {Name: ScanTypeBlackduckComponentRisk, FileFormats: []string{"zip"}},
+		ScanTypeBlackduckHub:                       {Name: ScanTypeBlackduckHub, FileFormats: []string{"csv", "zip"}},
+		ScanTypeBrakeman:                           {Name: ScanTypeBrakeman, FileFormats: []string{"json"}},
+		ScanTypeBugcrowdAPI:                        {Name: ScanTypeBugcrowdAPI, DedupeByUniqueID: true, NeedsService: true},
+		ScanTypeBundlerAudit:                       {Name: ScanTypeBundlerAudit, FileFormats: []string{"txt"}},
+		ScanTypeBurpEnterprise:                     {Name: ScanTypeBurpEnterprise, FileFormats: []string{"html"}},
+		ScanTypeBurpGraphQLAPI:                     {Name: ScanTypeBurpGraphQLAPI, FileFormats: []string{"json"}},
+		ScanTypeBurpRESTAPI:                        {Name: ScanTypeBurpRESTAPI, FileFormats: []string{"json"}},
+		ScanTypeBurp:                               {Name: ScanTypeBurp, FileFormats: []string{"xml"}},
+		ScanTypeCargoAudit:                         {Name: ScanTypeCargoAudit, FileFormats: []string{"json"}},
+		ScanTypeChefInspect:                        {Name: ScanTypeChefInspect, FileFormats: []string{"log"}},
+		ScanTypeCheckmarx:                          {Name: ScanTypeCheckmarx, FileFormats: []string{"xml"}},
+		ScanTypeCheckmarxDetailed:                  {Name: ScanTypeCheckmarxDetailed, FileFormats: []string{"xml"}, DedupeByUniqueID: true},
+		ScanTypeCheckmarxOne:                       {Name: ScanTypeCheckmarxOne, FileFormats: []string{"json"}, DedupeByUniqueID: true},
+		ScanTypeCheckmarxOSA:                       {Name: ScanTypeCheckmarxOSA, FileFormats: []string{"json"}, DedupeByUniqueID: true},
+		ScanTypeCheckov:                            {Name: ScanTypeCheckov, FileFormats: []string{"json"}},
+		ScanTypeClair:                              {Name: ScanTypeClair, FileFormats: []string{"json"}},
+		ScanTypeCloudsploit:                        {Name: ScanTypeCloudsploit, FileFormats: []string{"json"}},
+		ScanTypeCobaltIO:                           {Name: ScanTypeCobaltIO, FileFormats: []string{"csv"}},
+		ScanTypeCobaltIOAPI:                        {Name: ScanTypeCobaltIOAPI, DedupeByUniqueID: true, NeedsService: true},
+		ScanTypeCodechecker:                        {Name: ScanTypeCodechecker, FileFormats: []string{"json"}, DedupeByUniqueID: true},
+		ScanTypeContrast:                           {Name: ScanTypeContrast, FileFormats: []string{"csv"}},
+		ScanTypeCoverityAPI:                        {Name: ScanTypeCoverityAPI, FileFormats: []string{"json"}, DedupeByUniqueID: true},
+		ScanTypeCrashtest:                          {Name: ScanTypeCrashtest, FileFormats: []string{"json"}},
+		ScanTypeCredScan:                           {Name: ScanTypeCredScan, FileFormats: []string{"csv"}},
+		ScanTypeCycloneDX:                          {Name: ScanTypeCycloneDX, FileFormats: []string{"json", "xml"}},
+		ScanTypeDependencyCheck:                    {Name: ScanTypeDependencyCheck, FileFormats: []string{"xml"}},
+		ScanTypeDependencyTrack:                    {Name: ScanTypeDependencyTrack, FileFormats: []string{"json"}, DedupeByUniqueID: true},
+		ScanTypeDetectSecrets:                      {Name: ScanTypeDetectSecrets, FileFormats: []string{"json"}},
+		ScanTypeDockerBenchSecurity:                {Name: ScanTypeDockerBenchSecurity, FileFormats: []string{"json"}},
+		ScanTypeDockle:                             {Name: ScanTypeDockle, FileFormats: []string{"json"}},
+		ScanTypeDrHeader:                           {Name: ScanTypeDrHeader, FileFormats: []string{"json"}},
+		ScanTypeDSOP:                               {Name: ScanTypeDSOP, FileFormats: []string{"xlsx"}},
+		ScanTypeEdgescan:                           {Name: ScanTypeEdgescan, DedupeByUniqueID: true, NeedsService: true},
+		ScanTypeESLint:                             {Name: ScanTypeESLint, FileFormats: []string{"json"}},
+		ScanTypeFortify:                            {Name: ScanTypeFortify, FileFormats: []string{"fpr", "xml"}},
+		ScanTypeGeneric:                            {Name: ScanTypeGeneric, FileFormats: []string{"json", "csv"}},
+		ScanTypeGgshield:                           {Name: ScanTypeGgshield, FileFormats: []string{"json"}},
+		ScanTypeGithubVulnerability:                {Name: ScanTypeGithubVulnerability, FileFormats: []string{"json"}, DedupeByUniqueID: true},
+		ScanTypeGitLabAPIFuzzing:                   {Name: ScanTypeGitLabAPIFuzzing, FileFormats: []string{"json"}},
+		ScanTypeGitLabContainer:                    {Name: ScanTypeGitLabContainer, FileFormats: []string{"json"}, DedupeByUniqueID: true},
+		ScanTypeGitLabDAST:                         {Name: ScanTypeGitLabDAST, FileFormats: []string{"json"}, DedupeByUniqueID: true},
+		ScanTypeGitLabDependencyScanning:           {Name: ScanTypeGitLabDependencyScanning, FileFormats: []string{"json"}, DedupeByUniqueID: true},
+		ScanTypeGitLabSAST:                         {Name: ScanTypeGitLabSAST, FileFormats: []string{"json"}, DedupeByUniqueID: true},
+		ScanTypeGitLabSecretDetection:              {Name: ScanTypeGitLabSecretDetection, FileFormats: []string{"json"}, DedupeByUniqueID: true},
+		ScanTypeGitleaks:                           {Name: ScanTypeGitleaks, FileFormats: []string{"json"}},
+		ScanTypeGosec:                              {Name: ScanTypeGosec, FileFormats: []string{"json"}},
+		ScanTypeGovulncheck:                        {Name: ScanTypeGovulncheck, FileFormats: []string{"json"}},
+		ScanTypeHackerOne:                          {Name: ScanTypeHackerOne, FileFormats: []string{"json"}, DedupeByUniqueID: true},
+		ScanTypeHadolint:                           {Name: ScanTypeHadolint, FileFormats: []string{"json"}},
+		ScanTypeHarbor:                             {Name: ScanTypeHarbor, FileFormats: []string{"json"}},
+		ScanTypeHCLAppScan:                         {Name: ScanTypeHCLAppScan, FileFormats: []string{"xml"}},
+		ScanTypeHorusec:                            {Name: ScanTypeHorusec, FileFormats: []string{"json"}},
+		ScanTypeHuskyCI:                            {Name: ScanTypeHuskyCI, FileFormats: []string{"json"}},
+		ScanTypeHydra:                              {Name: ScanTypeHydra, FileFormats: []string{"json"}},
+		ScanTypeIBMAppScanDAST:                     {Name: ScanTypeIBMAppScanDAST, FileFormats: []string{"xml"}},
+		ScanTypeImmuniweb:                          {Name: ScanTypeImmuniweb, FileFormats: []string{"xml"}},
+		ScanTypeIntSights:                          {Name: ScanTypeIntSights, FileFormats: []string{"json", "csv"}, DedupeByUniqueID: true},
+		ScanTypeJFrogXray:                          {Name: ScanTypeJFrogXray, FileFormats: []string{"json"}},
+		ScanTypeJFrogXrayUnified:                   {Name: ScanTypeJFrogXrayUnified, FileFormats: []string{"json"}},
+		ScanTypeKICS:                               {Name: ScanTypeKICS, FileFormats: []string{"json"}},
+		ScanTypeKiuwan:                             {Name: ScanTypeKiuwan, FileFormats: []string{"csv"}},
+		ScanTypeKubeBench:                          {Name: ScanTypeKubeBench, FileFormats: []string{"json"}},
+		ScanTypeKubeaudit:                          {Name: ScanTypeKubeaudit, FileFormats: []string{"json"}},
+		ScanTypeKubehunter:                         {Name: ScanTypeKubehunter, FileFormats: []string{"json"}},
+		ScanTypeKubescape:                          {Name: ScanTypeKubescape, FileFormats: []string{"json"}},
+		ScanTypeMeterian:                           {Name: ScanTypeMeterian, FileFormats: []string{"json"}},
+		ScanTypeMicrofocusWebinspect:               {Name: ScanTypeMicrofocusWebinspect, FileFormats: []string{"xml"}},
+		ScanTypeMobSF:                              {Name: ScanTypeMobSF, FileFormats: []string{"json"}},
+		ScanTypeMobsfscan:                          {Name: ScanTypeMobsfscan, FileFormats: []string{"json"}, DedupeByUniqueID: true},
+		ScanTypeMozillaObservatory:                 {Name: ScanTypeMozillaObservatory, FileFormats: []string{"json"}},
+		ScanTypeNancy:                              {Name: ScanTypeNancy, FileFormats: []string{"json"}},
+		ScanTypeNetsparker:                         {Name: ScanTypeNetsparker, FileFormats: []string{"json"}},
+		ScanTypeNeuVectorCompliance:                {Name: ScanTypeNeuVectorCompliance, FileFormats: []string{"json"}},
+		ScanTypeNeuVectorREST:                      {Name: ScanTypeNeuVectorREST, FileFormats: []string{"json"}},
+		ScanTypeNexpose:                            {Name: ScanTypeNexpose, FileFormats: []string{"xml"}},
+		ScanTypeNikto:                              {Name: ScanTypeNikto, FileFormats: []string{"xml", "json"}},
+		ScanTypeNmap:                               {Name: ScanTypeNmap, FileFormats: []string{"xml"}},
+		ScanTypeNodeSecurityPlatform:               {Name: ScanTypeNodeSecurityPlatform, FileFormats: []string{"json"}},
+		ScanTypeNPMAudit:                           {Name: ScanTypeNPMAudit, FileFormats: []string{"json"}},
+		ScanTypeNPMAuditV7:                         {Name: ScanTypeNPMAuditV7, FileFormats: []string{"json"}},
+		ScanTypeNuclei:                             {Name: ScanTypeNuclei, FileFormats: []string{"json"}},
+		ScanTypeOpenscap:                           {Name: ScanTypeOpenscap, FileFormats: []string{"xml"}},
+		ScanTypeOpenVAS:                            {Name: ScanTypeOpenVAS, FileFormats: []string{"csv", "xml"}},
+		ScanTypeORT:                                {Name: ScanTypeORT, FileFormats: []string{"json"}},
+		ScanTypeOSV:                                {Name: ScanTypeOSV, FileFormats: []string{"json"}},
+		ScanTypeOutpost24:                          {Name: ScanTypeOutpost24, FileFormats: []string{"xml"}},
+		ScanTypePHPSecurityAuditV2:                 {Name: ScanTypePHPSecurityAuditV2, FileFormats: []string{"json"}},
+		ScanTypePHPSymfonySecurityCheck:            {Name: ScanTypePHPSymfonySecurityCheck, FileFormats: []string{"json"}},
+		ScanTypePipAudit:                           {Name: ScanTypePipAudit, FileFormats: []string{"json"}},
+		ScanTypePMD:                                {Name: ScanTypePMD, FileFormats: []string{"csv"}},
+		ScanTypePopeye:                             {Name: ScanTypePopeye, FileFormats: []string{"json"}},
+		ScanTypeQualys:                             {Name: ScanTypeQualys, FileFormats: []string{"xml", "csv"}},
+		ScanTypeQualysInfrastructureWebGUI:         {Name: ScanTypeQualysInfrastructureWebGUI, FileFormats: []string{"xml"}},
+		ScanTypeQualysWebapp:                       {Name: ScanTypeQualysWebapp, FileFormats: []string{"xml"}},
+		ScanTypeRetireJS:                           {Name: ScanTypeRetireJS, FileFormats: []string{"json"}},
+		ScanTypeRiskReconAPI:                       {Name: ScanTypeRiskReconAPI, NeedsService: true},
+		ScanTypeRubocop:                            {Name: ScanTypeRubocop, FileFormats: []string{"json"}},
+		ScanTypeRustyHog:                           {Name: ScanTypeRustyHog, FileFormats: []string{"json"}},
+		ScanTypeSARIF:                              {Name: ScanTypeSARIF, FileFormats: []string{"sarif", "json"}},
+		ScanTypeScantist:                           {Name: ScanTypeScantist, FileFormats: []string{"json"}},
+		ScanTypeScoutSuite:                         {Name: ScanTypeScoutSuite, FileFormats: []string{"js"}},
+		ScanTypeSemgrep:                            {Name: ScanTypeSemgrep, FileFormats: []string{"json"}, DedupeByUniqueID: true},
+		ScanTypeSKF:                                {Name: ScanTypeSKF, FileFormats: []string{"csv"}},
+		ScanTypeSnyk:                               {Name: ScanTypeSnyk, FileFormats: []string{"json"}},
+		ScanTypeSnykCode:                           {Name: ScanTypeSnykCode, FileFormats: []string{"json"}},
+		ScanTypeSolarAppscreener:                   {Name: ScanTypeSolarAppscreener, FileFormats: []string{"csv"}},
+		ScanTypeSonarQube:                          {Name: ScanTypeSonarQube, FileFormats: []string{"html"}, DedupeByUniqueID: true},
+		ScanTypeSonarQubeAPI:                       {Name: ScanTypeSonarQubeAPI, DedupeByUniqueID: true, NeedsService: true},
+		ScanTypeSonarQubeDetailed:                  {Name: ScanTypeSonarQubeDetailed, FileFormats: []string{"html"}, DedupeByUniqueID: true},
+		ScanTypeSonatypeApplication:                {Name: ScanTypeSonatypeApplication, FileFormats: []string{"json"}},
+		ScanTypeSpotBugs:                           {Name: ScanTypeSpotBugs, FileFormats: []string{"xml"}},
+		ScanTypeSSLLabs:                            {Name: ScanTypeSSLLabs, FileFormats: []string{"json"}},
+		ScanTypeSslscan:                            {Name: ScanTypeSslscan, FileFormats: []string{"xml"}},
+		ScanTypeSSLyzeJSON:                         {Name: ScanTypeSSLyzeJSON, FileFormats: []string{"json"}},
+		ScanTypeStackHawk:                          {Name: ScanTypeStackHawk, FileFormats: []string{"json"}},
+		ScanTypeTalisman:                           {Name: ScanTypeTalisman, FileFormats: []string{"json"}},
+		ScanTypeTenable:                            {Name: ScanTypeTenable, FileFormats: []string{"csv", "xml"}},
+		ScanTypeTerrascan:                          {Name: ScanTypeTerrascan, FileFormats: []string{"json"}},
+		ScanTypeTestssl:                            {Name: ScanTypeTestssl, FileFormats: []string{"csv"}},
+		ScanTypeTFSec:                              {Name: ScanTypeTFSec, FileFormats: []string{"json"}},
+		ScanTypeTrivy:                              {Name: ScanTypeTrivy, FileFormats: []string{"json"}},
+		ScanTypeTrivyOperator:                      {Name: ScanTypeTrivyOperator, FileFormats: []string{"json"}},
+		ScanTypeTrufflehog:                         {Name: ScanTypeTrufflehog, FileFormats: []string{"json"}},
+		ScanTypeTrufflehog3:                        {Name: ScanTypeTrufflehog3, FileFormats: []string{"json"}},
+		ScanTypeTrustwaveCSV:                       {Name: ScanTypeTrustwaveCSV, FileFormats: []string{"csv"}},
+		ScanTypeTwistlockImage:                     {Name: ScanTypeTwistlockImage, FileFormats: []string{"json", "csv"}},
+		ScanTypeVcg:                                {Name: ScanTypeVcg, FileFormats: []string{"xml", "csv"}},
+		ScanTypeVeracode:                           {Name: ScanTypeVeracode, FileFormats: []string{"xml"}, DedupeByUniqueID: true},
+		ScanTypeVeracodeSourceClear:                {Name: ScanTypeVeracodeSourceClear, FileFormats: []string{"json"}, DedupeByUniqueID: true},
+		ScanTypeVulners:                            {Name: ScanTypeVulners, NeedsService: true},
+		ScanTypeWapiti:                             {Name: ScanTypeWapiti, FileFormats: []string{"xml"}},
+		ScanTypeWFuzz:                              {Name: ScanTypeWFuzz, FileFormats: []string{"json"}},
+		ScanTypeWhispers:                           {Name: ScanTypeWhispers, FileFormats: []string{"json"}},
+		ScanTypeWhiteHatSentinel:                   {Name: ScanTypeWhiteHatSentinel, FileFormats: []string{"json"}},
+		ScanTypeWhitesource:                        {Name: ScanTypeWhitesource, FileFormats: []string{"json"}},
+		ScanTypeWiz:                                {Name: ScanTypeWiz, FileFormats: []string{"csv"}},
+		ScanTypeWpscan:                             {Name: ScanTypeWpscan, FileFormats: []string{"json"}},
+		ScanTypeXanitizer:                          {Name: ScanTypeXanitizer, FileFormats: []string{"xml"}},
+		ScanTypeYarnAudit:                          {Name: ScanTypeYarnAudit, FileFormats: []string{"json"}},
+		ScanTypeZAP:                                {Name: ScanTypeZAP, FileFormats: []string{"xml"}},
+	}
+)
+
+// RegisterScanType adds a parser to the catalogue, or replaces its description,
+// so that scan types newer than this package or provided by a customised DefectDojo instance pass validation.
+func RegisterScanType(info ScanTypeInfo) {
+	scanTypesMu.Lock()
+	defer scanTypesMu.Unlock()
+
+	scanTypes[info.Name] = info
+}
+
+// ScanTypes returns the description of every known parser, sorted by name.
+func ScanTypes() []ScanTypeInfo {
+	scanTypesMu.RLock()
+	defer scanTypesMu.RUnlock()
+
+	res := make([]ScanTypeInfo, 0, len(scanTypes))
+	for _, info := range scanTypes {
+		info.FileFormats = slices.Clone(info.FileFormats)
+		res = append(res, info)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Name < res[j].Name })
+
+	return res
+}
+
+// Info returns the description of the parser named s, and whether it is known.
+func (s ScanType) Info() (ScanTypeInfo, bool) {
+	scanTypesMu.RLock()
+	defer scanTypesMu.RUnlock()
+
+	info, ok := scanTypes[s]
+	info.FileFormats = slices.Clone(info.FileFormats)
+	return info, ok
+}
+
+// Ptr returns a pointer to the name of the parser, to fill the ScanType field of scan imports.
+func (s ScanType) Ptr() *string { return Str(string(s)) }
+
+// checkScanType validates the scan type of an import or reimport, unless the client allows unknown scan types.
+func (c *Client) checkScanType(s *string) error {
+	if c.AllowUnknownScanTypes || s == nil {
+		return nil
+	}
+	return ScanType(*s).Validate()
+}
+
+// Validate returns an error if s is not a known parser name. Names are case sensitive.
+func (s ScanType) Validate() error {
+	if _, ok := s.Info(); !ok {
+		return fmt.Errorf("unknown scan type %q", string(s))
+	}
+	return nil
+}
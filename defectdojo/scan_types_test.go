@@ -0,0 +1,145 @@
+package defectdojo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestScanType_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		scanType ScanType
+		wantErr  bool
+	}{
+		{name: "known", scanType: ScanTypeTrivy},
+		{name: "needs service", scanType: ScanTypeSonarQubeAPI},
+		{name: "legacy parser", scanType: ScanType("Whitesource Scan")},
+		{name: "wrong case", scanType: ScanType("trivy scan"), wantErr: true},
+		{name: "unknown", scanType: ScanType("Trivy"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.scanType.Validate()
+			if tt.wantErr != !cmp.Equal(err, nil) {
+				t.Errorf("unexpected error %v", err)
+			}
+		})
+	}
+}
+
+func TestScanType_Info(t *testing.T) {
+	actual, ok := ScanTypeSemgrep.Info()
+	if !ok {
+		t.Fatalf("expected %s to be known", ScanTypeSemgrep)
+	}
+
+	expected := ScanTypeInfo{Name: ScanTypeSemgrep, FileFormats: []string{"json"}, DedupeByUniqueID: true}
+	if !cmp.Equal(actual, expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, expected)
+	}
+}
+
+func TestScanType_Ptr(t *testing.T) {
+	if actual := ScanTypeTrivy.Ptr(); *actual != "Trivy Scan" {
+		t.Errorf("unexpected scan type %s", *actual)
+	}
+}
+
+func TestScanTypes(t *testing.T) {
+	actual := ScanTypes()
+	if !sort.SliceIsSorted(actual, func(i, j int) bool { return actual[i].Name < actual[j].Name }) {
+		t.Errorf("expected scan types sorted by name")
+	}
+	for _, info := range actual {
+		if len(info.FileFormats) == 0 && !info.NeedsService {
+			t.Errorf("%s reads neither a file nor a service", info.Name)
+		}
+	}
+}
+
+func TestRegisterScanType(t *testing.T) {
+	custom := ScanType("Acme Scanner")
+	if err := custom.Validate(); cmp.Equal(err, nil) {
+		t.Fatalf("expected %s to be unknown", custom)
+	}
+
+	RegisterScanType(ScanTypeInfo{Name: custom, FileFormats: []string{"json"}})
+	t.Cleanup(func() {
+		scanTypesMu.Lock()
+		defer scanTypesMu.Unlock()
+		delete(scanTypes, custom)
+	})
+
+	if err := custom.Validate(); !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+}
+
+func TestImportScanService_Create_unknownScanType(t *testing.T) {
+	t.Run("rejected by default", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			t.Errorf("no request should have been sent")
+		}))
+		defer ts.Close()
+
+		dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+		report := strings.NewReader(`{"Results": []}`)
+		_, err := dj.ImportScan.Create(context.Background(), &ImportScan{
+			ScanType:   Str("Trivy scan"),
+			FileUpload: &FileUpload{Name: "trivy.json", Reader: report},
+		})
+		if cmp.Equal(err, nil) {
+			t.Fatalf("expected an error for an unknown scan type")
+		}
+		if report.Len() != len(`{"Results": []}`) {
+			t.Errorf("the report should not have been read")
+		}
+
+		_, err = dj.ReImportScan.Create(context.Background(), &ReImportScan{
+			ScanType:   Str("Trivy scan"),
+			FileUpload: &FileUpload{Name: "trivy.json", Reader: report},
+		})
+		if cmp.Equal(err, nil) {
+			t.Errorf("expected an error for an unknown scan type")
+		}
+	})
+
+	t.Run("sent when allowed", func(t *testing.T) {
+		var scanTypes []string
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			scanTypes = append(scanTypes, r.FormValue("scan_type"))
+			_, _ = fmt.Fprintln(w, `{"test": 1}`)
+		}))
+		defer ts.Close()
+
+		dj, _ := NewClient(ts.URL, WithToken("token"), WithAllowUnknownScanTypes())
+
+		_, err := dj.ImportScan.Create(context.Background(), &ImportScan{
+			ScanType:   Str("Acme Scanner"),
+			FileUpload: &FileUpload{Name: "acme.json", Reader: strings.NewReader(`{}`)},
+		})
+		if !cmp.Equal(err, nil) {
+			t.Errorf("error: %s", err)
+		}
+		_, err = dj.ReImportScan.Create(context.Background(), &ReImportScan{
+			ScanType:   Str("Acme Scanner"),
+			FileUpload: &FileUpload{Name: "acme.json", Reader: strings.NewReader(`{}`)},
+		})
+		if !cmp.Equal(err, nil) {
+			t.Errorf("error: %s", err)
+		}
+
+		if !cmp.Equal(scanTypes, []string{"Acme Scanner", "Acme Scanner"}) {
+			t.Errorf("unexpected scan types %v", scanTypes)
+		}
+	})
+}
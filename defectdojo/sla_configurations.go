@@ -0,0 +1,275 @@
+package defectdojo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+type SLAConfigurationsService struct {
+	client *Client
+}
+
+// SLAConfiguration sets, per severity, the number of days a product has to mitigate its findings.
+type SLAConfiguration struct {
+	Id                       *int    `json:"id,omitempty"`
+	Name                     *string `json:"name,omitempty"`
+	Description              *string `json:"description,omitempty"`
+	Critical                 *int    `json:"critical,omitempty"`
+	EnforceCritical          *bool   `json:"enforce_critical,omitempty"`
+	High                     *int    `json:"high,omitempty"`
+	EnforceHigh              *bool   `json:"enforce_high,omitempty"`
+	Medium                   *int    `json:"medium,omitempty"`
+	EnforceMedium            *bool   `json:"enforce_medium,omitempty"`
+	Low                      *int    `json:"low,omitempty"`
+	EnforceLow               *bool   `json:"enforce_low,omitempty"`
+	RestartSlaOnReactivation *bool   `json:"restart_sla_on_reactivation,omitempty"`
+}
+
+type SLAConfigurations struct {
+	Count    *int                `json:"count,omitempty"`
+	Next     *string             `json:"next,omitempty"`
+	Previous *string             `json:"previous,omitempty"`
+	Results  *[]SLAConfiguration `json:"results,omitempty"`
+}
+
+type SLAConfigurationsOptions struct {
+	Limit  int
+	Offset int
+	ID     int
+	Name   string
+}
+
+func (o *SLAConfigurationsOptions) ToString() string {
+	if o == nil {
+		return ""
+	}
+
+	v := url.Values{}
+	if o.Limit > 0 {
+		v.Set("limit", strconv.Itoa(o.Limit))
+	}
+	if o.Offset > 0 {
+		v.Set("offset", strconv.Itoa(o.Offset))
+	}
+	if o.ID > 0 {
+		v.Set("id", strconv.Itoa(o.ID))
+	}
+	if len(o.Name) > 0 {
+		v.Set("name", o.Name)
+	}
+
+	return "?" + v.Encode()
+}
+
+func (c *SLAConfigurationsService) List(ctx context.Context, options *SLAConfigurationsOptions) (*SLAConfigurations, error) {
+	path := fmt.Sprintf("%s/sla_configurations/%s", c.client.BaseURL, options.ToString())
+
+	req, err := http.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := SLAConfigurations{}
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// All returns an iterator over all SLA configurations matching the given options,
+// fetching further pages from DefectDojo as the iteration advances.
+func (c *SLAConfigurationsService) All(ctx context.Context, options *SLAConfigurationsOptions) iter.Seq2[SLAConfiguration, error] {
+	path := fmt.Sprintf("%s/sla_configurations/%s", c.client.BaseURL, options.ToString())
+
+	return all[SLAConfiguration](ctx, c.client, path)
+}
+
+func (c *SLAConfigurationsService) Read(ctx context.Context, id int) (*SLAConfiguration, error) {
+	path := fmt.Sprintf("%s/sla_configurations/%d/", c.client.BaseURL, id)
+
+	req, err := http.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(SLAConfiguration)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *SLAConfigurationsService) Create(ctx context.Context, u *SLAConfiguration) (*SLAConfiguration, error) {
+	path := fmt.Sprintf("%s/sla_configurations/", c.client.BaseURL)
+
+	postJSON, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPost, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(SLAConfiguration)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *SLAConfigurationsService) Update(ctx context.Context, id int, u *SLAConfiguration) (*SLAConfiguration, error) {
+	path := fmt.Sprintf("%s/sla_configurations/%d/", c.client.BaseURL, id)
+
+	postJSON, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPut, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(SLAConfiguration)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *SLAConfigurationsService) PartialUpdate(ctx context.Context, id int, u *SLAConfiguration) (*SLAConfiguration, error) {
+	path := fmt.Sprintf("%s/sla_configurations/%d/", c.client.BaseURL, id)
+
+	postJSON, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPatch, path, bytes.NewBuffer(postJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(SLAConfiguration)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *SLAConfigurationsService) Delete(ctx context.Context, id int) (*SLAConfiguration, error) {
+	path := fmt.Sprintf("%s/sla_configurations/%d/", c.client.BaseURL, id)
+
+	req, err := http.NewRequest(http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	res := new(SLAConfiguration)
+	if err := c.client.sendRequest(req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// ForProduct returns the SLA configuration that applies to the findings of the product.
+func (c *SLAConfigurationsService) ForProduct(ctx context.Context, productID int) (*SLAConfiguration, error) {
+	p, err := c.client.Products.Read(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+	if p.SlaConfiguration == nil {
+		return nil, fmt.Errorf("product %d has no SLA configuration", productID)
+	}
+
+	return c.Read(ctx, *p.SlaConfiguration)
+}
+
+// SLAStatus is the state of the SLA of a finding at a given time.
+type SLAStatus struct {
+	// DueDate is the day by which the finding must be mitigated
+	DueDate time.Time
+	// DaysRemaining is the number of days left until DueDate, negative once it has passed
+	DaysRemaining int
+	// Breached tells whether DueDate has passed
+	Breached bool
+}
+
+// Days returns the number of days allowed to mitigate findings of the given severity,
+// and false if the configuration enforces no SLA for it. Info findings never have an SLA.
+func (s *SLAConfiguration) Days(severity Severity) (int, bool) {
+	var days *int
+	var enforce *bool
+	switch severity {
+	case SeverityCritical:
+		days, enforce = s.Critical, s.EnforceCritical
+	case SeverityHigh:
+		days, enforce = s.High, s.EnforceHigh
+	case SeverityMedium:
+		days, enforce = s.Medium, s.EnforceMedium
+	case SeverityLow:
+		days, enforce = s.Low, s.EnforceLow
+	}
+
+	// instances predating the enforce flags enforce every configured severity
+	if days == nil || (enforce != nil && !*enforce) {
+		return 0, false
+	}
+	return *days, true
+}
+
+// Evaluate computes, the way DefectDojo does, the SLA status at now of a finding of the given severity
+// whose SLA started at start, usually the date of the finding. Only the calendar day of start and now,
+// in the location of start, is taken into account. It returns false if no SLA applies to the severity.
+func (s *SLAConfiguration) Evaluate(severity Severity, start, now time.Time) (SLAStatus, bool) {
+	days, ok := s.Days(severity)
+	if !ok {
+		return SLAStatus{}, false
+	}
+
+	due := truncateToDay(start).AddDate(0, 0, days)
+	today := truncateToDay(now.In(start.Location()))
+	remaining := daysBetween(today, due)
+
+	return SLAStatus{
+		DueDate:       due,
+		DaysRemaining: remaining,
+		Breached:      remaining < 0,
+	}, true
+}
+
+func truncateToDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
+
+// daysBetween counts calendar days from a to b, ignoring daylight saving time shifts.
+func daysBetween(a, b time.Time) int {
+	ua := time.Date(a.Year(), a.Month(), a.Day(), 0, 0, 0, 0, time.UTC)
+	ub := time.Date(b.Year(), b.Month(), b.Day(), 0, 0, 0, 0, time.UTC)
+	return int(ub.Sub(ua).Hours() / 24)
+}
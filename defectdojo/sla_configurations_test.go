@@ -0,0 +1,402 @@
+package defectdojo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSLAConfigurationsService_List(t *testing.T) {
+	response := `{
+		"count": 1,
+		"next": null,
+		"previous": null,
+		"results": [
+			{
+				"id": 2,
+				"name": "Internet facing",
+				"critical": 7,
+				"enforce_critical": true,
+				"high": 30,
+				"enforce_high": true,
+				"medium": 90,
+				"enforce_medium": true,
+				"low": 120,
+				"enforce_low": false
+			}
+		]
+	}`
+
+	expected := SLAConfigurations{
+		Count:    Int(1),
+		Next:     nil,
+		Previous: nil,
+		Results: &[]SLAConfiguration{
+			{
+				Id:              Int(2),
+				Name:            Str("Internet facing"),
+				Critical:        Int(7),
+				EnforceCritical: Bool(true),
+				High:            Int(30),
+				EnforceHigh:     Bool(true),
+				Medium:          Int(90),
+				EnforceMedium:   Bool(true),
+				Low:             Int(120),
+				EnforceLow:      Bool(false),
+			},
+		},
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected GET request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/sla_configurations/") {
+			t.Errorf("Expected /sla_configurations/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.SLAConfigurations.List(context.Background(), &SLAConfigurationsOptions{
+		Limit: 10,
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestSLAConfigurationsService_Read(t *testing.T) {
+	response := `{
+		"id": 2,
+		"name": "Internet facing",
+		"critical": 7,
+		"enforce_critical": true,
+		"high": 30,
+		"enforce_high": true,
+		"medium": 90,
+		"enforce_medium": true,
+		"low": 120,
+		"enforce_low": false
+	}`
+
+	expected := SLAConfiguration{
+		Id:              Int(2),
+		Name:            Str("Internet facing"),
+		Critical:        Int(7),
+		EnforceCritical: Bool(true),
+		High:            Int(30),
+		EnforceHigh:     Bool(true),
+		Medium:          Int(90),
+		EnforceMedium:   Bool(true),
+		Low:             Int(120),
+		EnforceLow:      Bool(false),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Expected GET request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/sla_configurations/2/") {
+			t.Errorf("Expected /sla_configurations/2/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.SLAConfigurations.Read(context.Background(), 2)
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestSLAConfigurationsService_Create(t *testing.T) {
+	response := `{
+		"id": 2,
+		"name": "Internet facing",
+		"critical": 7,
+		"enforce_critical": true,
+		"high": 30,
+		"enforce_high": true,
+		"medium": 90,
+		"enforce_medium": true,
+		"low": 120,
+		"enforce_low": false
+	}`
+
+	expected := SLAConfiguration{
+		Id:              Int(2),
+		Name:            Str("Internet facing"),
+		Critical:        Int(7),
+		EnforceCritical: Bool(true),
+		High:            Int(30),
+		EnforceHigh:     Bool(true),
+		Medium:          Int(90),
+		EnforceMedium:   Bool(true),
+		Low:             Int(120),
+		EnforceLow:      Bool(false),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("Expected POST request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/sla_configurations/") {
+			t.Errorf("Expected /sla_configurations/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.SLAConfigurations.Create(context.Background(), &SLAConfiguration{
+		Name:     Str("Internet facing"),
+		Critical: Int(7),
+		High:     Int(30),
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestSLAConfigurationsService_Update(t *testing.T) {
+	response := `{
+		"id": 2,
+		"name": "Internet facing",
+		"critical": 7,
+		"enforce_critical": true,
+		"high": 30,
+		"enforce_high": true,
+		"medium": 90,
+		"enforce_medium": true,
+		"low": 120,
+		"enforce_low": false
+	}`
+
+	expected := SLAConfiguration{
+		Id:              Int(2),
+		Name:            Str("Internet facing"),
+		Critical:        Int(7),
+		EnforceCritical: Bool(true),
+		High:            Int(30),
+		EnforceHigh:     Bool(true),
+		Medium:          Int(90),
+		EnforceMedium:   Bool(true),
+		Low:             Int(120),
+		EnforceLow:      Bool(false),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Errorf("Expected PUT request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/sla_configurations/2/") {
+			t.Errorf("Expected /sla_configurations/2/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.SLAConfigurations.Update(context.Background(), 2, &SLAConfiguration{
+		Name:     Str("Internet facing"),
+		Critical: Int(7),
+		High:     Int(30),
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestSLAConfigurationsService_PartialUpdate(t *testing.T) {
+	response := `{
+		"id": 2,
+		"name": "Internet facing",
+		"critical": 7,
+		"enforce_critical": true,
+		"high": 30,
+		"enforce_high": true,
+		"medium": 90,
+		"enforce_medium": true,
+		"low": 120,
+		"enforce_low": false
+	}`
+
+	expected := SLAConfiguration{
+		Id:              Int(2),
+		Name:            Str("Internet facing"),
+		Critical:        Int(7),
+		EnforceCritical: Bool(true),
+		High:            Int(30),
+		EnforceHigh:     Bool(true),
+		Medium:          Int(90),
+		EnforceMedium:   Bool(true),
+		Low:             Int(120),
+		EnforceLow:      Bool(false),
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch {
+			t.Errorf("Expected PATCH request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/sla_configurations/2/") {
+			t.Errorf("Expected /sla_configurations/2/ in path, got %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprintln(w, response)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.SLAConfigurations.PartialUpdate(context.Background(), 2, &SLAConfiguration{
+		Name:     Str("Internet facing"),
+		Critical: Int(7),
+		High:     Int(30),
+	})
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+
+	if !cmp.Equal(actual, &expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, &expected)
+	}
+}
+
+func TestSLAConfigurationsService_Delete(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("Expected DELETE request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/sla_configurations/2/") {
+			t.Errorf("Expected /sla_configurations/2/ in path, got %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	_, err := dj.SLAConfigurations.Delete(context.Background(), 2)
+	if !cmp.Equal(err, nil) {
+		t.Errorf("error: %s", err)
+	}
+}
+
+func TestSLAConfigurationsService_ForProduct(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/products/3/":
+			_, _ = fmt.Fprintln(w, `{"id": 3, "sla_configuration": 2}`)
+		case "/api/v2/sla_configurations/2/":
+			_, _ = fmt.Fprintln(w, `{"id": 2, "name": "Internet facing"}`)
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+	}))
+	defer ts.Close()
+
+	dj, _ := NewDojoClient(ts.URL, "token", nil)
+
+	actual, err := dj.SLAConfigurations.ForProduct(context.Background(), 3)
+	if !cmp.Equal(err, nil) {
+		t.Fatalf("error: %s", err)
+	}
+
+	expected := &SLAConfiguration{Id: Int(2), Name: Str("Internet facing")}
+	if !cmp.Equal(actual, expected) {
+		t.Errorf("should have been equal, %+v, %+v", actual, expected)
+	}
+}
+
+func TestSLAConfiguration_Evaluate(t *testing.T) {
+	sla := &SLAConfiguration{
+		Critical:        Int(7),
+		EnforceCritical: Bool(true),
+		High:            Int(30),
+		Medium:          Int(90),
+		EnforceMedium:   Bool(true),
+		Low:             Int(120),
+		EnforceLow:      Bool(false),
+	}
+	start := time.Date(2024, 3, 1, 18, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		severity Severity
+		now      time.Time
+		expected SLAStatus
+		ok       bool
+	}{
+		{
+			name:     "within SLA",
+			severity: SeverityCritical,
+			now:      time.Date(2024, 3, 5, 9, 0, 0, 0, time.UTC),
+			expected: SLAStatus{DueDate: time.Date(2024, 3, 8, 0, 0, 0, 0, time.UTC), DaysRemaining: 3},
+			ok:       true,
+		},
+		{
+			name:     "due today",
+			severity: SeverityCritical,
+			now:      time.Date(2024, 3, 8, 23, 0, 0, 0, time.UTC),
+			expected: SLAStatus{DueDate: time.Date(2024, 3, 8, 0, 0, 0, 0, time.UTC), DaysRemaining: 0},
+			ok:       true,
+		},
+		{
+			name:     "breached",
+			severity: SeverityHigh,
+			now:      time.Date(2024, 4, 2, 0, 0, 0, 0, time.UTC),
+			expected: SLAStatus{DueDate: time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC), DaysRemaining: -2, Breached: true},
+			ok:       true,
+		},
+		{
+			name:     "not enforced",
+			severity: SeverityLow,
+			now:      start,
+		},
+		{
+			name:     "info",
+			severity: SeverityInfo,
+			now:      start,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, ok := sla.Evaluate(tt.severity, start, tt.now)
+			if ok != tt.ok {
+				t.Fatalf("expected ok to be %t", tt.ok)
+			}
+			if !cmp.Equal(actual, tt.expected) {
+				t.Errorf("should have been equal, %+v, %+v", actual, tt.expected)
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/http"
 	"strings"
 	"time"
@@ -75,6 +76,14 @@ func (c *TechnologiesService) List(ctx context.Context, options *TechnologiesOpt
 	return &res, nil
 }
 
+// All returns an iterator over all technologies matching the given options,
+// fetching further pages from DefectDojo as the iteration advances.
+func (c *TechnologiesService) All(ctx context.Context, options *TechnologiesOptions) iter.Seq2[Technology, error] {
+	path := fmt.Sprintf("%s/technologies/%s", c.client.BaseURL, options.ToString())
+
+	return all[Technology](ctx, c.client, path)
+}
+
 func (c *TechnologiesService) Read(ctx context.Context, id int) (*Technology, error) {
 	path := fmt.Sprintf("%s/technologies/%d/", c.client.BaseURL, id)
 
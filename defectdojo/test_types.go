@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/http"
 	"strings"
 )
@@ -72,6 +73,14 @@ func (c *TestTypesService) List(ctx context.Context, options *TestTypesOptions)
 	return &res, nil
 }
 
+// All returns an iterator over all test types matching the given options,
+// fetching further pages from DefectDojo as the iteration advances.
+func (c *TestTypesService) All(ctx context.Context, options *TestTypesOptions) iter.Seq2[TestType, error] {
+	path := fmt.Sprintf("%s/test_types/%s", c.client.BaseURL, options.ToString())
+
+	return all[TestType](ctx, c.client, path)
+}
+
 func (c *TestTypesService) Read(ctx context.Context, id int) (*TestType, error) {
 	path := fmt.Sprintf("%s/test_types/%d/", c.client.BaseURL, id)
 
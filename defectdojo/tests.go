@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/http"
 	"strings"
 )
@@ -90,6 +91,14 @@ func (c *TestsService) List(ctx context.Context, options *TestsOptions) (*Tests,
 	return &res, nil
 }
 
+// All returns an iterator over all tests matching the given options,
+// fetching further pages from DefectDojo as the iteration advances.
+func (c *TestsService) All(ctx context.Context, options *TestsOptions) iter.Seq2[Test, error] {
+	path := fmt.Sprintf("%s/tests/%s", c.client.BaseURL, options.ToString())
+
+	return all[Test](ctx, c.client, path)
+}
+
 func (c *TestsService) Read(ctx context.Context, id int) (*Test, error) {
 	path := fmt.Sprintf("%s/tests/%d/", c.client.BaseURL, id)
 
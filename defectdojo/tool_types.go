@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/http"
 	"strings"
 )
@@ -77,6 +78,14 @@ func (c *ToolTypesService) List(ctx context.Context, options *ToolTypesOptions)
 	return &res, nil
 }
 
+// All returns an iterator over all tool types matching the given options,
+// fetching further pages from DefectDojo as the iteration advances.
+func (c *ToolTypesService) All(ctx context.Context, options *ToolTypesOptions) iter.Seq2[ToolType, error] {
+	path := fmt.Sprintf("%s/tool_types/%s", c.client.BaseURL, options.ToString())
+
+	return all[ToolType](ctx, c.client, path)
+}
+
 func (c *ToolTypesService) Read(ctx context.Context, id int) (*ToolType, error) {
 	path := fmt.Sprintf("%s/tool_types/%d/", c.client.BaseURL, id)
 
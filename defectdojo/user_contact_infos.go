@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/http"
 	"strings"
 )
@@ -117,6 +118,14 @@ func (c *UserContactInfosService) List(ctx context.Context, options *UserContact
 	return &res, nil
 }
 
+// All returns an iterator over all user contact infos matching the given options,
+// fetching further pages from DefectDojo as the iteration advances.
+func (c *UserContactInfosService) All(ctx context.Context, options *UserContactInfosOptions) iter.Seq2[UserContactInfo, error] {
+	path := fmt.Sprintf("%s/user_contact_infos/%s", c.client.BaseURL, options.ToString())
+
+	return all[UserContactInfo](ctx, c.client, path)
+}
+
 func (c *UserContactInfosService) Read(ctx context.Context, id int) (*UserContactInfo, error) {
 	path := fmt.Sprintf("%s/user_contact_infos/%d/", c.client.BaseURL, id)
 
@@ -11,32 +11,12 @@ type UserProfileService struct {
 }
 
 type UserProfile struct {
-	User            *User            `json:"user,omitempty"`
-	UserContactInfo *UserContactInfo `json:"user_contact_info,omitempty"`
-	GlobalRole      *struct {
-		Id    *int `json:"id,omitempty"`
-		User  *int `json:"user,omitempty"`
-		Group *int `json:"group,omitempty"`
-		Role  *int `json:"role,omitempty"`
-	} `json:"global_role,omitempty"`
-	DojoGroupMember *[]struct {
-		Id    *int `json:"id,omitempty"`
-		Group *int `json:"group,omitempty"`
-		User  *int `json:"user,omitempty"`
-		Role  *int `json:"role,omitempty"`
-	} `json:"dojo_group_member,omitempty"`
-	ProductTypeMember *[]struct {
-		Id          *int `json:"id,omitempty"`
-		ProductType *int `json:"product_type,omitempty"`
-		User        *int `json:"user,omitempty"`
-		Role        *int `json:"role,omitempty"`
-	} `json:"product_type_member,omitempty"`
-	ProductMember *[]struct {
-		Id      *int `json:"id,omitempty"`
-		Product *int `json:"product,omitempty"`
-		User    *int `json:"user,omitempty"`
-		Role    *int `json:"role,omitempty"`
-	} `json:"product_member,omitempty"`
+	User              *User                `json:"user,omitempty"`
+	UserContactInfo   *UserContactInfo     `json:"user_contact_info,omitempty"`
+	GlobalRole        *GlobalRole          `json:"global_role,omitempty"`
+	DojoGroupMember   *[]DojoGroupMember   `json:"dojo_group_member,omitempty"`
+	ProductTypeMember *[]ProductTypeMember `json:"product_type_member,omitempty"`
+	ProductMember     *[]ProductMember     `json:"product_member,omitempty"`
 }
 
 func (c *UserProfileService) List(ctx context.Context) (*UserProfile, error) {
@@ -70,41 +70,21 @@ func TestUserProfileService_List(t *testing.T) {
 			ForcePasswordReset: Bool(false),
 			User:               Int(1),
 		},
-		GlobalRole: &struct {
-			Id    *int `json:"id,omitempty"`
-			User  *int `json:"user,omitempty"`
-			Group *int `json:"group,omitempty"`
-			Role  *int `json:"role,omitempty"`
-		}{
+		GlobalRole: &GlobalRole{
 			Id:   Int(1),
 			User: Int(1),
-			Role: Int(4),
+			Role: Ptr(RoleOwner),
 		},
-		DojoGroupMember: &[]struct {
-			Id    *int `json:"id,omitempty"`
-			Group *int `json:"group,omitempty"`
-			User  *int `json:"user,omitempty"`
-			Role  *int `json:"role,omitempty"`
-		}{
+		DojoGroupMember: &[]DojoGroupMember{
 			{
 				Id:    Int(1),
 				Group: Int(1),
 				User:  Int(1),
-				Role:  Int(4),
+				Role:  Ptr(RoleOwner),
 			},
 		},
-		ProductTypeMember: &[]struct {
-			Id          *int `json:"id,omitempty"`
-			ProductType *int `json:"product_type,omitempty"`
-			User        *int `json:"user,omitempty"`
-			Role        *int `json:"role,omitempty"`
-		}{},
-		ProductMember: &[]struct {
-			Id      *int `json:"id,omitempty"`
-			Product *int `json:"product,omitempty"`
-			User    *int `json:"user,omitempty"`
-			Role    *int `json:"role,omitempty"`
-		}{},
+		ProductTypeMember: &[]ProductTypeMember{},
+		ProductMember:     &[]ProductMember{},
 	}
 
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/http"
 	"strings"
 	"time"
@@ -93,6 +94,14 @@ func (c *UsersService) List(ctx context.Context, options *UsersOptions) (*Users,
 	return &res, nil
 }
 
+// All returns an iterator over all users matching the given options,
+// fetching further pages from DefectDojo as the iteration advances.
+func (c *UsersService) All(ctx context.Context, options *UsersOptions) iter.Seq2[User, error] {
+	path := fmt.Sprintf("%s/users/%s", c.client.BaseURL, options.ToString())
+
+	return all[User](ctx, c.client, path)
+}
+
 func (c *UsersService) Read(ctx context.Context, id int) (*User, error) {
 	path := fmt.Sprintf("%s/users/%d/", c.client.BaseURL, id)
 
@@ -1,6 +1,7 @@
 package defectdojo
 
 import (
+	"slices"
 	"time"
 )
 
@@ -20,6 +21,41 @@ func Str(s string) *string { return &s }
 // to store d and returns a pointer to it.
 func Date(d time.Time) *time.Time { return &d }
 
+// Ptr is a helper routine that allocates a new value of any type,
+// such as Severity or RoleID, to store v and returns a pointer to it.
+func Ptr[T any](v T) *T { return &v }
+
 // Slice is a helper routine that allocates a new slice value
 // to store v and returns a pointer to it.
 func Slice(v []string) *[]string { return &v }
+
+// mergeUnique returns the values of current followed by the values of add it does not contain yet.
+// It backs the helpers adding tags or findings to an object, which read the object and write the
+// merged list back with a partial update: DefectDojo offers no atomic action for these lists, so
+// a concurrent change made between the read and the write is lost.
+func mergeUnique[T comparable](current *[]T, add []T) []T {
+	var merged []T
+	if current != nil {
+		merged = append(merged, *current...)
+	}
+	for _, v := range add {
+		if !slices.Contains(merged, v) {
+			merged = append(merged, v)
+		}
+	}
+	return merged
+}
+
+// without returns the values of current not contained in remove, as a non-nil slice so that
+// emptying a list is sent as [] rather than omitted. It shares the caveat of mergeUnique.
+func without[T comparable](current *[]T, remove []T) []T {
+	remaining := []T{}
+	if current != nil {
+		for _, v := range *current {
+			if !slices.Contains(remove, v) {
+				remaining = append(remaining, v)
+			}
+		}
+	}
+	return remaining
+}
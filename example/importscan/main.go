@@ -39,7 +39,7 @@ func main() {
 		EngagementName:    defectdojo.Str("Hello1"),
 		AutoCreateContext: defectdojo.Bool(true),
 		File:              defectdojo.Str("/tmp/trivy.json"),
-		ScanType:          defectdojo.Str("Trivy Scan"),
+		ScanType:          defectdojo.ScanTypeTrivy.Ptr(),
 		Tags:              defectdojo.Slice([]string{"AAAA", "BBBB"}),
 	}
 